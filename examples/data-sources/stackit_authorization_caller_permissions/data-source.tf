@@ -0,0 +1,10 @@
+data "stackit_authorization_caller_permissions" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.roles, "editor")
+      error_message = "The service account used by Terraform is missing the role \"editor\" on the project."
+    }
+  }
+}
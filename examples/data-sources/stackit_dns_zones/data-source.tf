@@ -0,0 +1,5 @@
+data "stackit_dns_zones" "example" {
+  project_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  dns_name_like = "example.com"
+  active        = true
+}
@@ -0,0 +1,3 @@
+data "stackit_objectstorage_buckets" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
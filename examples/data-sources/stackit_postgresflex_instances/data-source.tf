@@ -0,0 +1,3 @@
+data "stackit_postgresflex_instances" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -2,3 +2,9 @@ data "stackit_security_group" "example" {
   project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   security_group_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
 }
+
+# Look up a security group by its name, e.g. a baseline group maintained by another team
+data "stackit_security_group" "by_name" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "baseline"
+}
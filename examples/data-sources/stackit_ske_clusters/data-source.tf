@@ -0,0 +1,3 @@
+data "stackit_ske_clusters" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
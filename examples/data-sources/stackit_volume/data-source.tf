@@ -2,3 +2,9 @@ data "stackit_volume" "example" {
   project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   volume_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
 }
+
+# Look up a volume by its name, e.g. a volume managed by another configuration
+data "stackit_volume" "by_name" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "my-volume"
+}
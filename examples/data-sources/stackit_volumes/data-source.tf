@@ -0,0 +1,4 @@
+data "stackit_volumes" "example" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  label_selector = "env=prod"
+}
@@ -0,0 +1,6 @@
+ephemeral "stackit_mongodbflex_user" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  roles       = ["read"]
+  database    = "database"
+}
@@ -0,0 +1,7 @@
+ephemeral "stackit_objectstorage_presigned_url" "example" {
+  bucket_name       = "example-bucket"
+  object_key        = "artifacts/app.tar.gz"
+  access_key_id     = "xxxxxxxxxxxxxxxxxxxx"
+  secret_access_key = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  expires_in        = 900
+}
@@ -0,0 +1,4 @@
+import {
+  to = stackit_postgresflex_database.example
+  id = provider::stackit::compose_id(var.project_id, var.instance_id, var.database_id)
+}
@@ -0,0 +1,3 @@
+output "database_id" {
+  value = provider::stackit::parse_id(stackit_postgresflex_database.example.id)[2]
+}
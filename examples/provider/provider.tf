@@ -24,3 +24,9 @@ provider "stackit" {
   private_key_path         = var.private_key_path
 }
 
+# Workload identity federation (e.g. in GitHub Actions or GitLab CI)
+provider "stackit" {
+  region                = "eu01"
+  use_oidc              = true
+  service_account_email = var.service_account_email
+}
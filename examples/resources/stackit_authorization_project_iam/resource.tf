@@ -0,0 +1,13 @@
+resource "stackit_authorization_project_iam" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  members = [
+    {
+      role    = "owner"
+      subject = "john.doe@stackit.cloud"
+    },
+    {
+      role    = "editor"
+      subject = "terraform@sa.stackit.cloud"
+    },
+  ]
+}
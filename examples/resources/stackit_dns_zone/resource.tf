@@ -8,3 +8,11 @@ resource "stackit_dns_zone" "example" {
   description   = "Example description"
   default_ttl   = 1230
 }
+
+resource "stackit_dns_zone" "example_clone" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name           = "Example zone clone"
+  dns_name       = "example-zone-clone.com"
+  clone_zone_id  = stackit_dns_zone.example.zone_id
+  adjust_records = true
+}
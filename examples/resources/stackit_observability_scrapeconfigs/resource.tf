@@ -0,0 +1,33 @@
+resource "stackit_observability_scrapeconfigs" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  jobs = {
+    "example-job" = {
+      metrics_path = "/my-metrics"
+      targets = [
+        {
+          urls = ["url1", "urls2"]
+          labels = {
+            "url1" = "dev"
+          }
+        }
+      ]
+    }
+    "other-job" = {
+      metrics_path    = "/metrics"
+      scrape_interval = "1m"
+      targets = [
+        {
+          urls = ["url3"]
+        }
+      ]
+    }
+  }
+}
+
+# The jobs can also be read from a YAML document
+resource "stackit_observability_scrapeconfigs" "example_yaml" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  jobs        = yamldecode(file("${path.module}/scrape-jobs.yaml"))
+}
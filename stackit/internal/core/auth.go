@@ -0,0 +1,113 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	sdkauth "github.com/stackitcloud/stackit-sdk-go/core/auth"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// authRoundTrippers caches the authenticated round trippers by the credentials they were set up with.
+// Terraform configures a provider instance per provider block (e.g. for every alias), and the key flow
+// round tripper keeps its access token until it is about to expire. Sharing the round tripper between
+// instances with the same credentials therefore avoids requesting a new token for each of them.
+var (
+	authRoundTrippers      = map[string]http.RoundTripper{}
+	authRoundTrippersMutex sync.Mutex
+)
+
+// SetupAuth returns the round tripper authenticating the API requests with the credentials in the given configuration.
+// The requests, including the ones for access tokens, are sent with the given transport of the provider instance.
+// Round trippers are cached and reused for the same credentials and transport.
+func SetupAuth(cfg *config.Configuration, transport http.RoundTripper) (http.RoundTripper, error) {
+	key := fmt.Sprintf("%s,%p", authCacheKey(cfg), transport)
+
+	authRoundTrippersMutex.Lock()
+	defer authRoundTrippersMutex.Unlock()
+	if roundTripper, ok := authRoundTrippers[key]; ok {
+		return roundTripper, nil
+	}
+	// The SDK looks up the credentials (e.g. in the environment or the credentials file), but its flows
+	// send the requests with their own HTTP clients, so they are replaced by flows using the transport
+	sdkRoundTripper, err := sdkauth.SetupAuth(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var roundTripper http.RoundTripper
+	switch flow := sdkRoundTripper.(type) {
+	case *clients.KeyFlow:
+		keyFlowConfig := flow.GetConfig()
+		roundTripper, err = newKeyFlow(&keyFlowConfig, transport)
+		if err != nil {
+			return nil, fmt.Errorf("configuring key authentication: %w", err)
+		}
+	case *clients.TokenFlow:
+		roundTripper = &tokenFlow{
+			token:     flow.GetConfig().ServiceAccountToken,
+			transport: transport,
+		}
+	default:
+		roundTripper = sdkRoundTripper
+	}
+	authRoundTrippers[key] = roundTripper
+	return roundTripper, nil
+}
+
+// authCacheKey returns a hash of the credentials in the given configuration, so that they aren't kept in plain text as cache key.
+func authCacheKey(cfg *config.Configuration) string {
+	hash := sha256.New()
+	for _, value := range []string{
+		cfg.CredentialsFilePath,
+		cfg.ServiceAccountKey,
+		cfg.ServiceAccountKeyPath,
+		cfg.PrivateKey,
+		cfg.PrivateKeyPath,
+		cfg.Token,
+		cfg.TokenCustomUrl,
+	} {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// CallerEmail returns the email of the service account authenticated by the given round tripper.
+// It is empty if the email can't be determined, e.g. for custom authentication flows.
+func CallerEmail(roundTripper http.RoundTripper) string {
+	switch flow := roundTripper.(type) {
+	case *keyFlow:
+		return flow.key.Credentials.Iss
+	case *tokenFlow:
+		return tokenEmail(flow.token)
+	case *oidcFlow:
+		return flow.config.ServiceAccountEmail
+	}
+	return ""
+}
+
+// tokenEmail returns the "email" claim of the given JWT. The token isn't verified, the API does that on every request.
+func tokenEmail(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Email
+}
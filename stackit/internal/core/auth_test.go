@@ -0,0 +1,74 @@
+package core
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+func TestSetupAuth(t *testing.T) {
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_KEY_PATH", "")
+	t.Setenv("STACKIT_PRIVATE_KEY_PATH", "")
+
+	first, err := SetupAuth(&config.Configuration{Token: "token"}, http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	second, err := SetupAuth(&config.Configuration{Token: "token"}, http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if first != second {
+		t.Fatalf("Expected the round tripper to be reused for the same credentials")
+	}
+
+	other, err := SetupAuth(&config.Configuration{Token: "other-token"}, http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if other == first {
+		t.Fatalf("Expected a new round tripper for different credentials")
+	}
+}
+
+func TestCallerEmail(t *testing.T) {
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_KEY_PATH", "")
+	t.Setenv("STACKIT_PRIVATE_KEY_PATH", "")
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"email":"sa@sa.stackit.cloud"}`))
+	tests := []struct {
+		description string
+		token       string
+		expected    string
+	}{
+		{
+			"token_with_email",
+			"header." + payload + ".signature",
+			"sa@sa.stackit.cloud",
+		},
+		{
+			"token_without_email",
+			"header." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"id"}`)) + ".signature",
+			"",
+		},
+		{
+			"no_jwt",
+			"caller-email-token",
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			roundTripper, err := SetupAuth(&config.Configuration{Token: tt.token}, http.DefaultTransport)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			email := CallerEmail(roundTripper)
+			if email != tt.expected {
+				t.Fatalf("Expected email %q, got %q", tt.expected, email)
+			}
+		})
+	}
+}
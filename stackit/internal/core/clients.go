@@ -0,0 +1,45 @@
+package core
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// ClientCache memoizes the API clients of a provider instance, one per service.
+type ClientCache struct {
+	mutex   sync.Mutex
+	clients map[reflect.Type]any
+}
+
+// NewClientCache returns an empty ClientCache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{
+		clients: map[reflect.Type]any{},
+	}
+}
+
+// NewAPIClient returns the API client of a service, which is created with newClient and the given options on first use.
+// All resources and data sources of a service share the client created for the first of them, so the options must only
+// depend on the provider data. If the provider data has no client cache (e.g. in unit tests), a new client is created on
+// every call.
+func NewAPIClient[T any](providerData *ProviderData, newClient func(...config.ConfigurationOption) (T, error), opts ...config.ConfigurationOption) (T, error) {
+	cache := providerData.ClientCache
+	if cache == nil {
+		return newClient(opts...)
+	}
+
+	key := reflect.TypeFor[T]()
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if client, ok := cache.clients[key]; ok {
+		return client.(T), nil
+	}
+	client, err := newClient(opts...)
+	if err != nil {
+		return client, err
+	}
+	cache.clients[key] = client
+	return client, nil
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+type testAPIClient struct {
+	id int
+}
+
+func TestNewAPIClient(t *testing.T) {
+	calls := 0
+	newClient := func(_ ...config.ConfigurationOption) (*testAPIClient, error) {
+		calls++
+		return &testAPIClient{id: calls}, nil
+	}
+
+	t.Run("with_cache", func(t *testing.T) {
+		calls = 0
+		providerData := &ProviderData{ClientCache: NewClientCache()}
+		first, err := NewAPIClient(providerData, newClient)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		second, err := NewAPIClient(providerData, newClient)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if first != second {
+			t.Fatalf("Expected the client to be reused")
+		}
+		if calls != 1 {
+			t.Fatalf("Expected 1 client to be created, got %d", calls)
+		}
+	})
+
+	t.Run("without_cache", func(t *testing.T) {
+		calls = 0
+		providerData := &ProviderData{}
+		first, err := NewAPIClient(providerData, newClient)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		second, err := NewAPIClient(providerData, newClient)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if first == second {
+			t.Fatalf("Expected a new client to be created")
+		}
+		if calls != 2 {
+			t.Fatalf("Expected 2 clients to be created, got %d", calls)
+		}
+	})
+
+	t.Run("error_not_cached", func(t *testing.T) {
+		calls = 0
+		providerData := &ProviderData{ClientCache: NewClientCache()}
+		_, err := NewAPIClient(providerData, func(_ ...config.ConfigurationOption) (*testAPIClient, error) {
+			return nil, fmt.Errorf("invalid configuration")
+		})
+		if err == nil {
+			t.Fatalf("Should have failed")
+		}
+		_, err = NewAPIClient(providerData, newClient)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if calls != 1 {
+			t.Fatalf("Expected 1 client to be created, got %d", calls)
+		}
+	})
+}
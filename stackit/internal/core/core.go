@@ -15,8 +15,14 @@ const Separator = ","
 
 type ProviderData struct {
 	RoundTripper                    http.RoundTripper
-	ServiceAccountEmail             string // Deprecated: ServiceAccountEmail is not required and will be removed after 12th June 2025.
+	Transport                       http.RoundTripper // Unauthenticated transport with the proxy and TLS settings, e.g. for uploads to pre-signed URLs
+	ClientCache                     *ClientCache
+	ResponseCache                   *ResponseCache
+	CallerEmail                     string
 	Region                          string
+	DefaultProjectId                string
+	DefaultLabels                   map[string]string
+	IgnoreLabels                    []string
 	ArgusCustomEndpoint             string
 	AuthorizationCustomEndpoint     string
 	DnsCustomEndpoint               string
@@ -39,6 +45,9 @@ type ProviderData struct {
 	SKECustomEndpoint               string
 	ServiceEnablementCustomEndpoint string
 	EnableBetaResources             bool
+	EnablePlanTimeChecks            bool
+	EnableProjectValidation         bool
+	EnableSensitiveAttributesAudit  bool
 }
 
 // DiagsToError Converts TF diagnostics' errors into an error with a human-readable description.
@@ -60,14 +69,18 @@ func DiagsToError(diags diag.Diagnostics) error {
 	return fmt.Errorf("%s", strings.Join(diagsStrings, ";"))
 }
 
-// LogAndAddError Logs the error and adds it to the diags
+// LogAndAddError Logs the error and adds it to the diags. Values marked with MaskSensitiveValues are masked.
 func LogAndAddError(ctx context.Context, diags *diag.Diagnostics, summary, detail string) {
+	summary = maskSensitiveValues(ctx, summary)
+	detail = maskSensitiveValues(ctx, detail)
 	tflog.Error(ctx, fmt.Sprintf("%s | %s", summary, detail))
 	diags.AddError(summary, detail)
 }
 
-// LogAndAddWarning Logs the warning and adds it to the diags
+// LogAndAddWarning Logs the warning and adds it to the diags. Values marked with MaskSensitiveValues are masked.
 func LogAndAddWarning(ctx context.Context, diags *diag.Diagnostics, summary, detail string) {
+	summary = maskSensitiveValues(ctx, summary)
+	detail = maskSensitiveValues(ctx, detail)
 	tflog.Warn(ctx, fmt.Sprintf("%s | %s", summary, detail))
 	diags.AddWarning(summary, detail)
 }
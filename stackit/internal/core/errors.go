@@ -0,0 +1,252 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// APIError holds the information of an error payload returned by a STACKIT API
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Details    []APIErrorDetail
+}
+
+// APIErrorDetail is a single error reported by a STACKIT API.
+// Field is set if the error refers to a field of the request payload, e.g. "name" or "body.acl".
+type APIErrorDetail struct {
+	Field   string
+	Message string
+}
+
+var (
+	messageKeys = []string{"message", "msg", "title", "error", "detail", "description"}
+	detailsKeys = []string{"details", "detail", "errors", "fields"}
+	fieldKeys   = []string{"field", "key", "path", "loc", "pointer", "name"}
+
+	// Request parts which prefix the location of a field, they are not part of the field name
+	locationPrefixes = map[string]bool{"body": true, "query": true, "path": true, "header": true}
+
+	indexRegex     = regexp.MustCompile(`\[[0-9]*\]`)
+	camelCaseRegex = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+)
+
+// ParseAPIError extracts the structured information of the error payload of a failed API call.
+// STACKIT APIs don't share a single error format, so the common layouts (code, message and a list of
+// details, optionally referring to fields of the request payload) are all supported.
+// Returns false if err is not an API error or its payload is not a JSON object.
+func ParseAPIError(err error) (*APIError, bool) {
+	var oapiErr *oapierror.GenericOpenAPIError
+	if !errors.As(err, &oapiErr) {
+		return nil, false
+	}
+
+	var payload map[string]any
+	if json.Unmarshal(oapiErr.Body, &payload) != nil {
+		return nil, false
+	}
+
+	apiErr := &APIError{
+		StatusCode: oapiErr.StatusCode,
+		Message:    oapiErr.ErrorMessage,
+	}
+	if code, ok := payload["code"]; ok && code != nil {
+		apiErr.Code = fmt.Sprint(code)
+	}
+	if message := firstString(payload, messageKeys); message != "" {
+		apiErr.Message = message
+	}
+	for _, key := range detailsKeys {
+		if s, ok := payload[key].(string); ok && s == apiErr.Message {
+			continue
+		}
+		apiErr.Details = append(apiErr.Details, parseAPIErrorDetails(payload[key])...)
+	}
+	return apiErr, true
+}
+
+func parseAPIErrorDetails(v any) []APIErrorDetail {
+	details := []APIErrorDetail{}
+	switch d := v.(type) {
+	case string:
+		if d != "" {
+			details = append(details, APIErrorDetail{Message: d})
+		}
+	case []any:
+		for _, item := range d {
+			switch i := item.(type) {
+			case string:
+				details = append(details, APIErrorDetail{Message: i})
+			case map[string]any:
+				message := firstString(i, messageKeys)
+				if message == "" {
+					continue
+				}
+				details = append(details, APIErrorDetail{
+					Field:   fieldName(i),
+					Message: message,
+				})
+			}
+		}
+	case map[string]any:
+		// Map of field names to error messages
+		fields := make([]string, 0, len(d))
+		for field := range d {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			switch m := d[field].(type) {
+			case string:
+				details = append(details, APIErrorDetail{Field: field, Message: m})
+			case []any:
+				for _, message := range m {
+					if s, ok := message.(string); ok {
+						details = append(details, APIErrorDetail{Field: field, Message: s})
+					}
+				}
+			}
+		}
+	}
+	return details
+}
+
+func firstString(m map[string]any, keys []string) string {
+	for _, key := range keys {
+		if s, ok := m[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func fieldName(m map[string]any) string {
+	for _, key := range fieldKeys {
+		switch f := m[key].(type) {
+		case string:
+			if f != "" {
+				return f
+			}
+		case []any:
+			// Location of the field, e.g. ["body", "acl", 0]
+			parts := []string{}
+			for i, part := range f {
+				s, ok := part.(string)
+				if !ok || (i == 0 && locationPrefixes[s]) {
+					continue
+				}
+				parts = append(parts, s)
+			}
+			if len(parts) > 0 {
+				return strings.Join(parts, ".")
+			}
+		}
+	}
+	return ""
+}
+
+// PathMatcher is implemented by the data of a request (e.g. tfsdk.Plan or tfsdk.Config), it is used to check
+// whether an attribute path exists in the schema of the resource.
+type PathMatcher interface {
+	PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics)
+}
+
+// AttributePath returns the path of the attribute that corresponds to the given field of an API payload,
+// e.g. "body.nodePools[0].name" is mapped to node_pools[0].name.
+// Returns false if the field can't be mapped to an attribute that exists in the given data, e.g. because
+// the API field is named differently than the attribute.
+func AttributePath(ctx context.Context, field string, data PathMatcher) (path.Path, bool) {
+	if data == nil {
+		return path.Empty(), false
+	}
+
+	segments := strings.FieldsFunc(field, func(r rune) bool { return r == '.' || r == '/' })
+	if len(segments) > 1 && locationPrefixes[segments[0]] {
+		segments = segments[1:]
+	}
+
+	attributePath := path.Empty()
+	for _, segment := range segments {
+		name := indexRegex.ReplaceAllString(segment, "")
+		if index, err := strconv.Atoi(name); err == nil {
+			attributePath = attributePath.AtListIndex(index)
+			continue
+		}
+		if name == "" {
+			return path.Empty(), false
+		}
+		attributePath = attributePath.AtName(strings.ToLower(camelCaseRegex.ReplaceAllString(name, "${1}_${2}")))
+		for _, match := range indexRegex.FindAllString(segment, -1) {
+			index, err := strconv.Atoi(strings.Trim(match, "[]"))
+			if err != nil {
+				return path.Empty(), false
+			}
+			attributePath = attributePath.AtListIndex(index)
+		}
+	}
+	if len(attributePath.Steps()) == 0 {
+		return path.Empty(), false
+	}
+
+	matches, diags := data.PathMatches(ctx, attributePath.Expression())
+	if diags.HasError() || len(matches) != 1 || !matches[0].Equal(attributePath) {
+		return path.Empty(), false
+	}
+	return attributePath, true
+}
+
+// LogAndAddAPIError logs the error of a failed API call and adds it to the diags.
+// If the API returned a structured error payload, its message is shown instead of the raw response
+// and the errors referring to fields of the request payload are added to the corresponding attributes of data.
+// Errors which can't be mapped to an attribute, e.g. because data is nil, are added to the resource-level error.
+func LogAndAddAPIError(ctx context.Context, diags *diag.Diagnostics, summary string, err error, data PathMatcher) {
+	apiErr, ok := ParseAPIError(err)
+	if !ok {
+		LogAndAddError(ctx, diags, summary, fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	detail := fmt.Sprintf("Calling API: %s (status code %d", apiErr.Message, apiErr.StatusCode)
+	if apiErr.Code != "" {
+		detail += fmt.Sprintf(", error code %s", apiErr.Code)
+	}
+	detail += ")"
+
+	resourceDetails := []string{}
+	for _, d := range apiErr.Details {
+		if d.Field == "" {
+			resourceDetails = append(resourceDetails, d.Message)
+			continue
+		}
+		attributePath, ok := AttributePath(ctx, d.Field, data)
+		if !ok {
+			resourceDetails = append(resourceDetails, fmt.Sprintf("%s: %s", d.Field, d.Message))
+			continue
+		}
+		attributeSummary := maskSensitiveValues(ctx, summary)
+		attributeDetail := maskSensitiveValues(ctx, fmt.Sprintf("%s\n%s", detail, d.Message))
+		tflog.Error(ctx, fmt.Sprintf("%s | %s: %s", attributeSummary, attributePath, attributeDetail))
+		diags.AddAttributeError(attributePath, attributeSummary, attributeDetail)
+	}
+
+	// The resource-level error is skipped if all details were added to attributes
+	if len(apiErr.Details) > 0 && len(resourceDetails) == 0 {
+		return
+	}
+	for _, d := range resourceDetails {
+		detail += fmt.Sprintf("\n- %s", d)
+	}
+	LogAndAddError(ctx, diags, summary, detail)
+}
@@ -0,0 +1,339 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+func TestParseAPIError(t *testing.T) {
+	tests := []struct {
+		description string
+		input       error
+		expected    *APIError
+		isValid     bool
+	}{
+		{
+			"code_message_details",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusBadRequest,
+				ErrorMessage: "400 Bad Request",
+				Body:         []byte(`{"code": "InvalidArgument", "message": "invalid request", "details": [{"field": "instanceName", "message": "too long"}, "other problem"]}`),
+			},
+			&APIError{
+				StatusCode: http.StatusBadRequest,
+				Code:       "InvalidArgument",
+				Message:    "invalid request",
+				Details: []APIErrorDetail{
+					{Field: "instanceName", Message: "too long"},
+					{Message: "other problem"},
+				},
+			},
+			true,
+		},
+		{
+			"validation_error_locations",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusUnprocessableEntity,
+				ErrorMessage: "422 Unprocessable Entity",
+				Body:         []byte(`{"detail": [{"loc": ["body", "acl", 0], "msg": "invalid CIDR", "type": "value_error"}]}`),
+			},
+			&APIError{
+				StatusCode: http.StatusUnprocessableEntity,
+				Message:    "422 Unprocessable Entity",
+				Details: []APIErrorDetail{
+					{Field: "acl", Message: "invalid CIDR"},
+				},
+			},
+			true,
+		},
+		{
+			"field_map",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusBadRequest,
+				ErrorMessage: "400 Bad Request",
+				Body:         []byte(`{"code": 400, "error": "validation failed", "fields": {"name": "required", "labels": ["invalid key", "invalid value"]}}`),
+			},
+			&APIError{
+				StatusCode: http.StatusBadRequest,
+				Code:       "400",
+				Message:    "validation failed",
+				Details: []APIErrorDetail{
+					{Field: "labels", Message: "invalid key"},
+					{Field: "labels", Message: "invalid value"},
+					{Field: "name", Message: "required"},
+				},
+			},
+			true,
+		},
+		{
+			"wrapped_error",
+			fmt.Errorf("wrapped: %w", &oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusConflict,
+				ErrorMessage: "409 Conflict",
+				Body:         []byte(`{"title": "Conflict", "detail": "name already in use"}`),
+			}),
+			&APIError{
+				StatusCode: http.StatusConflict,
+				Message:    "Conflict",
+				Details: []APIErrorDetail{
+					{Message: "name already in use"},
+				},
+			},
+			true,
+		},
+		{
+			"message_in_detail",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusNotFound,
+				ErrorMessage: "404 Not Found",
+				Body:         []byte(`{"detail": "instance not found"}`),
+			},
+			&APIError{
+				StatusCode: http.StatusNotFound,
+				Message:    "instance not found",
+			},
+			true,
+		},
+		{
+			"no_json_payload",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusBadGateway,
+				ErrorMessage: "502 Bad Gateway",
+				Body:         []byte("<html>Bad Gateway</html>"),
+			},
+			nil,
+			false,
+		},
+		{
+			"no_api_error",
+			fmt.Errorf("some error"),
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, ok := ParseAPIError(tt.input)
+			if !tt.isValid && ok {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && !ok {
+				t.Fatalf("Should not have failed")
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+// testPlan returns a plan with a top-level, a nested and a list nested attribute
+func testPlan(t *testing.T) tfsdk.Plan {
+	t.Helper()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+			"flavor": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"cpu": schema.Int64Attribute{Required: true},
+				},
+			},
+			"node_pools": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+			"options": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+	schemaType := testSchema.Type().TerraformType(context.Background())
+	nodePoolType := schemaType.(tftypes.Object).AttributeTypes["node_pools"].(tftypes.List).ElementType
+	return tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "name"),
+			"flavor": tftypes.NewValue(schemaType.(tftypes.Object).AttributeTypes["flavor"], map[string]tftypes.Value{
+				"cpu": tftypes.NewValue(tftypes.Number, 2),
+			}),
+			"node_pools": tftypes.NewValue(tftypes.List{ElementType: nodePoolType}, []tftypes.Value{
+				tftypes.NewValue(nodePoolType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "pool"),
+				}),
+			}),
+			"options": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+	}
+}
+
+func TestAttributePath(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    path.Path
+		isValid     bool
+	}{
+		{
+			"simple",
+			"name",
+			path.Root("name"),
+			true,
+		},
+		{
+			"nested",
+			"body.flavor.cpu",
+			path.Root("flavor").AtName("cpu"),
+			true,
+		},
+		{
+			"list_index",
+			"body.nodePools[0].name",
+			path.Root("node_pools").AtListIndex(0).AtName("name"),
+			true,
+		},
+		{
+			"pointer",
+			"/nodePools/0/name",
+			path.Root("node_pools").AtListIndex(0).AtName("name"),
+			true,
+		},
+		{
+			"field_not_in_schema",
+			"flavorId",
+			path.Empty(),
+			false,
+		},
+		{
+			"map_key",
+			"body.options.maxConnections",
+			path.Empty(),
+			false,
+		},
+		{
+			"list_index_out_of_range",
+			"nodePools[1].name",
+			path.Empty(),
+			false,
+		},
+		{
+			"location_prefix_only",
+			"body",
+			path.Empty(),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, ok := AttributePath(context.Background(), tt.input, testPlan(t))
+			if !tt.isValid && ok {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && !ok {
+				t.Fatalf("Should not have failed")
+			}
+			if tt.isValid && !output.Equal(tt.expected) {
+				t.Fatalf("Expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestAttributePathNoData(t *testing.T) {
+	if _, ok := AttributePath(context.Background(), "name", nil); ok {
+		t.Fatalf("Should have failed")
+	}
+}
+
+func TestLogAndAddAPIError(t *testing.T) {
+	tests := []struct {
+		description             string
+		input                   error
+		expectedErrors          int
+		expectedAttributeErrors int
+		expectedDetail          string
+	}{
+		{
+			"field_errors",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusBadRequest,
+				ErrorMessage: "400 Bad Request",
+				Body:         []byte(`{"code": "InvalidArgument", "message": "invalid request", "details": [{"field": "name", "message": "too long"}, "other problem"]}`),
+			},
+			2,
+			1,
+			"Calling API: invalid request (status code 400, error code InvalidArgument)\n- other problem",
+		},
+		{
+			"only_attribute_errors",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusBadRequest,
+				ErrorMessage: "400 Bad Request",
+				Body:         []byte(`{"message": "invalid request", "details": [{"field": "nodePools[0].name", "message": "too long"}]}`),
+			},
+			1,
+			1,
+			"Calling API: invalid request (status code 400)\ntoo long",
+		},
+		{
+			"unresolved_field",
+			&oapierror.GenericOpenAPIError{
+				StatusCode:   http.StatusBadRequest,
+				ErrorMessage: "400 Bad Request",
+				Body:         []byte(`{"message": "invalid request", "details": [{"field": "flavorId", "message": "not found"}]}`),
+			},
+			1,
+			0,
+			"Calling API: invalid request (status code 400)\n- flavorId: not found",
+		},
+		{
+			"no_api_error",
+			fmt.Errorf("some error"),
+			1,
+			0,
+			"Calling API: some error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			LogAndAddAPIError(context.Background(), &diags, "Error creating instance", tt.input, testPlan(t))
+
+			if diags.ErrorsCount() != tt.expectedErrors {
+				t.Fatalf("Expected %d errors, got %d", tt.expectedErrors, diags.ErrorsCount())
+			}
+			attributeErrors := 0
+			for _, d := range diags.Errors() {
+				if _, ok := d.(diag.DiagnosticWithPath); ok {
+					attributeErrors++
+				}
+			}
+			if attributeErrors != tt.expectedAttributeErrors {
+				t.Fatalf("Expected %d attribute errors, got %d", tt.expectedAttributeErrors, attributeErrors)
+			}
+			errs := diags.Errors()
+			if detail := errs[len(errs)-1].Detail(); detail != tt.expectedDetail {
+				t.Fatalf("Expected detail %q, got %q", tt.expectedDetail, detail)
+			}
+		})
+	}
+}
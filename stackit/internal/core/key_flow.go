@@ -0,0 +1,213 @@
+package core
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+const (
+	// DefaultKeyFlowTokenEndpoint is the endpoint where the access tokens of a service account key are requested
+	DefaultKeyFlowTokenEndpoint = "https://service-account.api.stackit.cloud/token"
+
+	keyFlowGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	// keyFlowAssertionLifetime is the lifetime of the self-signed JWT exchanged for an access token
+	keyFlowAssertionLifetime = 10 * time.Minute
+	// tokenRefreshLeeway is the time before the expiration of an access token at which a new one is requested
+	tokenRefreshLeeway = time.Minute
+)
+
+// keyFlow authenticates requests with the access tokens of a service account key. It replaces the key flow of
+// the SDK, which sends the token requests with its own HTTP client, so that the token requests use the transport
+// of the provider (e.g. its proxy and TLS settings) as well.
+type keyFlow struct {
+	key        *clients.ServiceAccountKeyResponse
+	privateKey *rsa.PrivateKey
+	tokenUrl   string
+	transport  http.RoundTripper
+	client     *http.Client
+
+	mutex        sync.Mutex
+	accessToken  string
+	expiresAt    time.Time
+	refreshToken string
+}
+
+func newKeyFlow(cfg *clients.KeyFlowConfig, transport http.RoundTripper) (*keyFlow, error) {
+	if cfg.ServiceAccountKey == nil || cfg.ServiceAccountKey.Credentials == nil {
+		return nil, fmt.Errorf("service account key has no credentials")
+	}
+	privateKey, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+	tokenUrl := cfg.TokenUrl
+	if tokenUrl == "" {
+		tokenUrl = DefaultKeyFlowTokenEndpoint
+	}
+	return &keyFlow{
+		key:        cfg.ServiceAccountKey,
+		privateKey: privateKey,
+		tokenUrl:   tokenUrl,
+		transport:  transport,
+		client:     &http.Client{Transport: transport, Timeout: clients.DefaultClientTimeout},
+	}, nil
+}
+
+// RoundTrip adds the access token of the service account to the request.
+func (f *keyFlow) RoundTrip(req *http.Request) (*http.Response, error) {
+	accessToken, err := f.getAccessToken(req)
+	if err != nil {
+		return nil, err
+	}
+	// The request must not be modified by a RoundTripper
+	authReq := req.Clone(req.Context())
+	authReq.Header.Set("Authorization", "Bearer "+accessToken)
+	return f.transport.RoundTrip(authReq)
+}
+
+func (f *keyFlow) getAccessToken(req *http.Request) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.accessToken != "" && time.Now().Before(f.expiresAt.Add(-tokenRefreshLeeway)) {
+		return f.accessToken, nil
+	}
+
+	form := url.Values{}
+	if f.refreshToken != "" && !tokenExpired(f.refreshToken) {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", f.refreshToken)
+	} else {
+		assertion, err := f.assertion()
+		if err != nil {
+			return "", fmt.Errorf("signing assertion: %w", err)
+		}
+		form.Set("grant_type", keyFlowGrantType)
+		form.Set("assertion", assertion)
+	}
+	tokenReq, err := http.NewRequestWithContext(req.Context(), http.MethodPost, f.tokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("building token request: %w", err)
+	}
+	tokenReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var tokenResp struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	err = doJSON(f.client, tokenReq, &tokenResp)
+	if err != nil {
+		return "", fmt.Errorf("requesting access token: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("requesting access token: no access token in response")
+	}
+
+	f.accessToken = tokenResp.AccessToken
+	f.expiresAt = tokenExpiration(tokenResp.AccessToken)
+	f.refreshToken = tokenResp.RefreshToken
+	return f.accessToken, nil
+}
+
+// assertion returns the JWT signed with the private key of the service account key, which is exchanged for an access token.
+func (f *keyFlow) assertion() (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS512",
+		"typ": "JWT",
+		"kid": f.key.Credentials.Kid,
+	})
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims, err := json.Marshal(map[string]any{
+		"iss": f.key.Credentials.Iss,
+		"sub": f.key.Credentials.Sub,
+		"jti": uuid.New(),
+		"aud": f.key.Credentials.Aud,
+		"iat": now.Unix(),
+		"exp": now.Add(keyFlowAssertionLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha512.Sum512([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, f.privateKey, crypto.SHA512, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// tokenFlow authenticates requests with a service account token.
+type tokenFlow struct {
+	token     string
+	transport http.RoundTripper
+}
+
+// RoundTrip adds the service account token to the request.
+func (f *tokenFlow) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The request must not be modified by a RoundTripper
+	authReq := req.Clone(req.Context())
+	authReq.Header.Set("Authorization", "Bearer "+f.token)
+	return f.transport.RoundTrip(authReq)
+}
+
+// parsePrivateKey parses a PEM encoded RSA private key in PKCS #1 or PKCS #8 format.
+func parsePrivateKey(privateKeyPem string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded key found")
+	}
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("key is not an RSA private key")
+	}
+	return privateKey, nil
+}
+
+// tokenExpiration returns the expiration time of the given JWT. The token isn't verified, the API does that on every request.
+// Returns the zero time if the expiration can't be determined, so that a new token is requested.
+func tokenExpiration(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+func tokenExpired(token string) bool {
+	return time.Now().After(tokenExpiration(token).Add(-tokenRefreshLeeway))
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+)
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func testJWT(claims string) string {
+	return "header." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+}
+
+func TestKeyFlow(t *testing.T) {
+	t.Setenv("STACKIT_SERVICE_ACCOUNT_KEY_PATH", "")
+	t.Setenv("STACKIT_PRIVATE_KEY_PATH", "")
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Generating key: %v", err)
+	}
+	privateKeyPem := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	}))
+	serviceAccountKey := `{"id": "7a9b3c1e-0000-0000-0000-000000000000", "credentials": {"aud": "https://stackit-service-account-prod.apps.01.cf.eu01.stackit.cloud", "iss": "sa@sa.stackit.cloud", "kid": "kid", "sub": "7a9b3c1e-0000-0000-0000-000000000001"}}`
+
+	accessToken := testJWT(fmt.Sprintf(`{"exp": %d}`, time.Now().Add(time.Hour).Unix()))
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokenRequests++
+			if err := r.ParseForm(); err != nil {
+				t.Errorf("Parsing form: %v", err)
+			}
+			if r.Form.Get("grant_type") != keyFlowGrantType || strings.Count(r.Form.Get("assertion"), ".") != 2 {
+				t.Errorf("Unexpected token request: %v", r.Form)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"access_token": accessToken})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+accessToken {
+			t.Errorf("Unexpected authorization header %q", r.Header.Get("Authorization"))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+	roundTripper, err := SetupAuth(&config.Configuration{
+		ServiceAccountKey: serviceAccountKey,
+		PrivateKey:        privateKeyPem,
+		TokenCustomUrl:    server.URL + "/token",
+	}, transport)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if email := CallerEmail(roundTripper); email != "sa@sa.stackit.cloud" {
+		t.Fatalf("Expected email %q, got %q", "sa@sa.stackit.cloud", email)
+	}
+
+	client := &http.Client{Transport: roundTripper}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL + "/v1/projects")
+		if err != nil {
+			t.Fatalf("Request should have succeeded: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if tokenRequests != 1 {
+		t.Fatalf("Expected the access token to be reused, got %d token requests", tokenRequests)
+	}
+	if transport.requests != 3 {
+		t.Fatalf("Expected all requests to be sent with the transport, got %d", transport.requests)
+	}
+}
+
+func TestTokenExpiration(t *testing.T) {
+	tests := []struct {
+		description string
+		token       string
+		expected    time.Time
+	}{
+		{
+			"token_with_exp",
+			testJWT(`{"exp": 1700000000}`),
+			time.Unix(1700000000, 0),
+		},
+		{
+			"token_without_exp",
+			testJWT(`{"sub": "id"}`),
+			time.Time{},
+		},
+		{
+			"no_jwt",
+			"token",
+			time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := tokenExpiration(tt.token)
+			if !output.Equal(tt.expected) {
+				t.Fatalf("Expected %v, got %v", tt.expected, output)
+			}
+		})
+	}
+}
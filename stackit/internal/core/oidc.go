@@ -0,0 +1,188 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/clients"
+)
+
+const (
+	// DefaultOIDCTokenEndpoint is the endpoint where the OIDC tokens are exchanged for access tokens of a service account
+	DefaultOIDCTokenEndpoint = "https://accounts.stackit.cloud/oauth/v2/token"
+
+	oidcClientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	oidcGrantType           = "client_credentials"
+	// oidcDefaultTokenLifetime is assumed if the token endpoint returns no lifetime and the access token has no expiration
+	oidcDefaultTokenLifetime = 10 * time.Minute
+)
+
+// OIDCConfig holds the settings of the workload identity federation.
+// The OIDC token is taken from Token, TokenPath or, in GitHub Actions, requested from RequestURL, in that order.
+type OIDCConfig struct {
+	ServiceAccountEmail string
+	Token               string
+	TokenPath           string
+	RequestURL          string
+	RequestToken        string
+	Audience            string
+	TokenEndpoint       string
+}
+
+// oidcFlow authenticates requests with access tokens of a service account, which are obtained
+// by exchanging an OIDC token of the CI system (e.g. GitHub Actions or GitLab CI).
+type oidcFlow struct {
+	config    OIDCConfig
+	transport http.RoundTripper
+	client    *http.Client
+
+	mutex       sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewOIDCRoundTripper returns the round tripper authenticating the API requests with the workload identity federation.
+// The token requests and the authenticated requests are sent with the given transport.
+func NewOIDCRoundTripper(cfg OIDCConfig, transport http.RoundTripper) (http.RoundTripper, error) {
+	if cfg.ServiceAccountEmail == "" {
+		return nil, fmt.Errorf("the service account email must be set")
+	}
+	if cfg.Token == "" && cfg.TokenPath == "" && (cfg.RequestURL == "" || cfg.RequestToken == "") {
+		return nil, fmt.Errorf("no OIDC token found: set the token or its path, or run in GitHub Actions with the permission \"id-token: write\"")
+	}
+	if cfg.TokenEndpoint == "" {
+		cfg.TokenEndpoint = DefaultOIDCTokenEndpoint
+	}
+	return &oidcFlow{
+		config:    cfg,
+		transport: transport,
+		client:    &http.Client{Transport: transport, Timeout: clients.DefaultClientTimeout},
+	}, nil
+}
+
+// RoundTrip adds the access token of the service account to the request.
+func (f *oidcFlow) RoundTrip(req *http.Request) (*http.Response, error) {
+	accessToken, err := f.getAccessToken(req)
+	if err != nil {
+		return nil, err
+	}
+	// The request must not be modified by a RoundTripper
+	authReq := req.Clone(req.Context())
+	authReq.Header.Set("Authorization", "Bearer "+accessToken)
+	return f.transport.RoundTrip(authReq)
+}
+
+func (f *oidcFlow) getAccessToken(req *http.Request) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.accessToken != "" && time.Now().Before(f.expiresAt.Add(-tokenRefreshLeeway)) {
+		return f.accessToken, nil
+	}
+
+	oidcToken, err := f.getOIDCToken(req)
+	if err != nil {
+		return "", fmt.Errorf("getting OIDC token: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", oidcGrantType)
+	form.Set("client_id", f.config.ServiceAccountEmail)
+	form.Set("client_assertion_type", oidcClientAssertionType)
+	form.Set("client_assertion", oidcToken)
+	tokenReq, err := http.NewRequestWithContext(req.Context(), http.MethodPost, f.config.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("building token request: %w", err)
+	}
+	tokenReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	err = doJSON(f.client, tokenReq, &tokenResp)
+	if err != nil {
+		return "", fmt.Errorf("exchanging OIDC token: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("exchanging OIDC token: no access token in response")
+	}
+
+	f.accessToken = tokenResp.AccessToken
+	switch {
+	case tokenResp.ExpiresIn > 0:
+		f.expiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	case !tokenExpiration(tokenResp.AccessToken).IsZero():
+		f.expiresAt = tokenExpiration(tokenResp.AccessToken)
+	default:
+		f.expiresAt = time.Now().Add(oidcDefaultTokenLifetime)
+	}
+	return f.accessToken, nil
+}
+
+// getOIDCToken returns the OIDC token of the CI system. The token file is read every time,
+// since it may be refreshed during long running operations.
+func (f *oidcFlow) getOIDCToken(req *http.Request) (string, error) {
+	if f.config.Token != "" {
+		return f.config.Token, nil
+	}
+	if f.config.TokenPath != "" {
+		token, err := os.ReadFile(f.config.TokenPath)
+		if err != nil {
+			return "", fmt.Errorf("reading token file: %w", err)
+		}
+		return strings.TrimSpace(string(token)), nil
+	}
+
+	requestURL, err := url.Parse(f.config.RequestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing token request URL: %w", err)
+	}
+	if f.config.Audience != "" {
+		query := requestURL.Query()
+		query.Set("audience", f.config.Audience)
+		requestURL.RawQuery = query.Encode()
+	}
+	oidcReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, requestURL.String(), http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("building token request: %w", err)
+	}
+	oidcReq.Header.Set("Authorization", "Bearer "+f.config.RequestToken)
+
+	var oidcResp struct {
+		Value string `json:"value"`
+	}
+	err = doJSON(f.client, oidcReq, &oidcResp)
+	if err != nil {
+		return "", fmt.Errorf("requesting token: %w", err)
+	}
+	if oidcResp.Value == "" {
+		return "", fmt.Errorf("requesting token: no token in response")
+	}
+	return oidcResp.Value, nil
+}
+
+// doJSON sends the request with the given client and decodes the JSON response into v.
+func doJSON(client *http.Client, req *http.Request, v any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,159 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOIDCRoundTripper(t *testing.T) {
+	tests := []struct {
+		description   string
+		config        OIDCConfig
+		tokenFile     string
+		expiresIn     int
+		expectedToken string
+		isValid       bool
+	}{
+		{
+			"token",
+			OIDCConfig{
+				ServiceAccountEmail: "sa@sa.stackit.cloud",
+				Token:               "oidc-token",
+			},
+			"",
+			3600,
+			"oidc-token",
+			true,
+		},
+		{
+			"no_expires_in",
+			OIDCConfig{
+				ServiceAccountEmail: "sa@sa.stackit.cloud",
+				Token:               "oidc-token",
+			},
+			"",
+			0,
+			"oidc-token",
+			true,
+		},
+		{
+			"token_path",
+			OIDCConfig{
+				ServiceAccountEmail: "sa@sa.stackit.cloud",
+			},
+			"file-token\n",
+			3600,
+			"file-token",
+			true,
+		},
+		{
+			"github_actions",
+			OIDCConfig{
+				ServiceAccountEmail: "sa@sa.stackit.cloud",
+				RequestToken:        "request-token",
+				Audience:            "stackit",
+			},
+			"",
+			3600,
+			"github-token",
+			true,
+		},
+		{
+			"no_service_account_email",
+			OIDCConfig{
+				Token: "oidc-token",
+			},
+			"",
+			3600,
+			"",
+			false,
+		},
+		{
+			"no_token",
+			OIDCConfig{
+				ServiceAccountEmail: "sa@sa.stackit.cloud",
+			},
+			"",
+			3600,
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			exchanges := 0
+			tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				exchanges++
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("Parsing form: %v", err)
+				}
+				if r.PostForm.Get("client_id") != tt.config.ServiceAccountEmail {
+					t.Errorf("Expected client ID %q, got %q", tt.config.ServiceAccountEmail, r.PostForm.Get("client_id"))
+				}
+				if r.PostForm.Get("client_assertion") != tt.expectedToken {
+					t.Errorf("Expected client assertion %q, got %q", tt.expectedToken, r.PostForm.Get("client_assertion"))
+				}
+				fmt.Fprintf(w, `{"access_token":"access-token","expires_in":%d}`, tt.expiresIn)
+			}))
+			defer tokenServer.Close()
+			githubServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer request-token" {
+					t.Errorf("Unexpected authorization header %q", r.Header.Get("Authorization"))
+				}
+				if r.URL.Query().Get("audience") != "stackit" {
+					t.Errorf("Unexpected audience %q", r.URL.Query().Get("audience"))
+				}
+				fmt.Fprint(w, `{"value":"github-token"}`)
+			}))
+			defer githubServer.Close()
+			apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer access-token" {
+					t.Errorf("Unexpected authorization header %q", r.Header.Get("Authorization"))
+				}
+			}))
+			defer apiServer.Close()
+
+			cfg := tt.config
+			cfg.TokenEndpoint = tokenServer.URL
+			if cfg.RequestToken != "" {
+				cfg.RequestURL = githubServer.URL + "?api-version=2.0"
+			}
+			if tt.tokenFile != "" {
+				cfg.TokenPath = filepath.Join(t.TempDir(), "token")
+				if err := os.WriteFile(cfg.TokenPath, []byte(tt.tokenFile), 0o600); err != nil {
+					t.Fatalf("Writing token file: %v", err)
+				}
+			}
+
+			roundTripper, err := NewOIDCRoundTripper(cfg, http.DefaultTransport)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !tt.isValid {
+				return
+			}
+
+			client := &http.Client{Transport: roundTripper}
+			for i := 0; i < 2; i++ {
+				resp, err := client.Get(apiServer.URL)
+				if err != nil {
+					t.Fatalf("Should not have failed: %v", err)
+				}
+				_ = resp.Body.Close()
+			}
+			if exchanges != 1 {
+				t.Fatalf("Expected the access token to be reused, got %d token exchanges", exchanges)
+			}
+			if email := CallerEmail(roundTripper); email != cfg.ServiceAccountEmail {
+				t.Fatalf("Expected caller email %q, got %q", cfg.ServiceAccountEmail, email)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"sync"
+)
+
+// ResponseCache memoizes API responses of a provider instance which are needed by many resources, e.g. the list of
+// databases of an instance, which is requested to read each of its databases. Resources changing the listed objects
+// must invalidate the cached response.
+type ResponseCache struct {
+	mutex   sync.Mutex
+	entries map[string]*responseCacheEntry
+}
+
+type responseCacheEntry struct {
+	mutex sync.Mutex
+	done  bool
+	value any
+}
+
+// NewResponseCache returns an empty ResponseCache.
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{
+		entries: map[string]*responseCacheEntry{},
+	}
+}
+
+// CachedResponse returns the response stored for the key, which is requested with fetch on first use. Concurrent
+// calls with the same key wait for a single request, failed requests are not cached. If the cache is nil (e.g. in
+// unit tests), fetch is called every time.
+func CachedResponse[T any](cache *ResponseCache, key string, fetch func() (T, error)) (T, error) {
+	if cache == nil {
+		return fetch()
+	}
+
+	cache.mutex.Lock()
+	entry, ok := cache.entries[key]
+	if !ok {
+		entry = &responseCacheEntry{}
+		cache.entries[key] = entry
+	}
+	cache.mutex.Unlock()
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+	if entry.done {
+		return entry.value.(T), nil
+	}
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+	entry.value = value
+	entry.done = true
+	return value, nil
+}
+
+// Invalidate removes the response stored for the key, so that it is requested again on next use.
+func (c *ResponseCache) Invalidate(key string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.entries, key)
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestCachedResponse(t *testing.T) {
+	calls := 0
+	fetch := func() (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	t.Run("with_cache", func(t *testing.T) {
+		calls = 0
+		cache := NewResponseCache()
+		first, err := CachedResponse(cache, "key", fetch)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		second, err := CachedResponse(cache, "key", fetch)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if first != second {
+			t.Fatalf("Expected the response to be reused")
+		}
+		other, err := CachedResponse(cache, "other", fetch)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if other == first {
+			t.Fatalf("Expected a new response for another key")
+		}
+		if calls != 2 {
+			t.Fatalf("Expected 2 requests, got %d", calls)
+		}
+	})
+
+	t.Run("without_cache", func(t *testing.T) {
+		calls = 0
+		for range 2 {
+			_, err := CachedResponse(nil, "key", fetch)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		}
+		if calls != 2 {
+			t.Fatalf("Expected 2 requests, got %d", calls)
+		}
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		calls = 0
+		cache := NewResponseCache()
+		first, err := CachedResponse(cache, "key", fetch)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		cache.Invalidate("key")
+		second, err := CachedResponse(cache, "key", fetch)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if first == second {
+			t.Fatalf("Expected the response to be requested again")
+		}
+	})
+
+	t.Run("error_not_cached", func(t *testing.T) {
+		calls = 0
+		cache := NewResponseCache()
+		_, err := CachedResponse(cache, "key", func() (int, error) {
+			return 0, fmt.Errorf("service unavailable")
+		})
+		if err == nil {
+			t.Fatalf("Should have failed")
+		}
+		_, err = CachedResponse(cache, "key", fetch)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if calls != 1 {
+			t.Fatalf("Expected 1 request, got %d", calls)
+		}
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		calls = 0
+		cache := NewResponseCache()
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = CachedResponse(cache, "key", fetch)
+			}()
+		}
+		wg.Wait()
+		if calls != 1 {
+			t.Fatalf("Expected 1 request, got %d", calls)
+		}
+	})
+}
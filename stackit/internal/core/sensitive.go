@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RedactedValue replaces sensitive values in the logs and diagnostics.
+const RedactedValue = "[REDACTED]"
+
+// sensitiveFieldNames are the suffixes of field names (compared case-insensitively) whose values are sensitive,
+// e.g. "password", "accessToken" or "secretAccessKey". Connection URIs are sensitive as well, since they may contain credentials.
+// Only suffixes are matched, so that e.g. "keyId" or "securityGroups" are not redacted.
+var sensitiveFieldNames = []string{"password", "secret", "token", "key", "credential", "kubeconfig", "certificate", "uri"}
+
+type sensitiveValuesKey struct{}
+
+// IsSensitiveFieldName returns whether the values of the JSON or log field with the given name are sensitive.
+// The plural of a sensitive field name is sensitive as well, e.g. "credentials".
+func IsSensitiveFieldName(name string) bool {
+	name = strings.TrimSuffix(strings.ToLower(name), "s")
+	for _, sensitiveFieldName := range sensitiveFieldNames {
+		if strings.HasSuffix(name, sensitiveFieldName) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactJSON replaces the values of the sensitive fields of decoded JSON content, including the ones of nested objects.
+func RedactJSON(content any) any {
+	switch c := content.(type) {
+	case map[string]any:
+		for name, value := range c {
+			if value != nil && IsSensitiveFieldName(name) {
+				c[name] = RedactedValue
+				continue
+			}
+			c[name] = RedactJSON(value)
+		}
+	case []any:
+		for i, value := range c {
+			c[i] = RedactJSON(value)
+		}
+	}
+	return content
+}
+
+// MaskSensitiveValues marks the given values (e.g. passwords, access keys or kubeconfigs) as sensitive.
+// They are masked in all log entries written with the returned context and in the diagnostics added with
+// LogAndAddError, LogAndAddWarning and LogAndAddAPIError, e.g. if an API echoes them in an error payload.
+// Empty values are ignored.
+func MaskSensitiveValues(ctx context.Context, values ...string) context.Context {
+	masked := []string{}
+	for _, value := range values {
+		if value != "" {
+			masked = append(masked, value)
+		}
+	}
+	if len(masked) == 0 {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, sensitiveValuesKey{}, append(slices.Clone(sensitiveValues(ctx)), masked...))
+	ctx = tflog.MaskMessageStrings(ctx, masked...)
+	return tflog.MaskAllFieldValuesStrings(ctx, masked...)
+}
+
+func sensitiveValues(ctx context.Context) []string {
+	values, _ := ctx.Value(sensitiveValuesKey{}).([]string)
+	return values
+}
+
+// maskSensitiveValues replaces the values marked as sensitive in the context with RedactedValue.
+func maskSensitiveValues(ctx context.Context, s string) string {
+	for _, value := range sensitiveValues(ctx) {
+		s = strings.ReplaceAll(s, value, RedactedValue)
+	}
+	return s
+}
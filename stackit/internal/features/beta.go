@@ -14,7 +14,7 @@ import (
 //
 // In order of precedence, beta functionality can be managed by:
 //   - Environment Variable `STACKIT_TF_ENABLE_BETA_RESOURCES` - `true` is enabled, `false` is disabled.
-//   - Provider configuration feature flag `enable_beta` - `true` is enabled, `false` is disabled.
+//   - Provider configuration feature flag `enable_beta_resources` - `true` is enabled, `false` is disabled.
 func BetaResourcesEnabled(ctx context.Context, data *core.ProviderData, diags *diag.Diagnostics) bool {
 	value, set := os.LookupEnv("STACKIT_TF_ENABLE_BETA_RESOURCES")
 	if set {
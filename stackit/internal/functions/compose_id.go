@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &composeIdFunction{}
+
+// NewComposeIdFunction is a helper function to simplify the provider implementation.
+func NewComposeIdFunction() function.Function {
+	return &composeIdFunction{}
+}
+
+// composeIdFunction is the function implementation.
+type composeIdFunction struct{}
+
+// Metadata returns the function name.
+func (f *composeIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compose_id"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *composeIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds the ID of a resource from its parts.",
+		MarkdownDescription: fmt.Sprintf("Joins the given parts with `%s` to the ID of a resource, e.g. `provider::stackit::compose_id(project_id, instance_id, database_id)` returns `project_id%sinstance_id%sdatabase_id`. The result can be used as import ID.", core.Separator, core.Separator, core.Separator),
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			MarkdownDescription: "The parts of the ID, in the order of the import ID of the resource. Parts must not be empty or contain the separator.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the ID from the given parts.
+func (f *composeIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := composeId(parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
+
+func composeId(parts []string) (string, error) {
+	if len(parts) == 0 {
+		return "", fmt.Errorf("at least one part must be given")
+	}
+	for i, part := range parts {
+		if part == "" {
+			return "", fmt.Errorf("part %d is empty", i+1)
+		}
+		if strings.Contains(part, core.Separator) {
+			return "", fmt.Errorf("part %d (%q) contains the separator %q", i+1, part, core.Separator)
+		}
+	}
+	return strings.Join(parts, core.Separator), nil
+}
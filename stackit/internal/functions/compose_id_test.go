@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"testing"
+)
+
+func TestComposeId(t *testing.T) {
+	tests := []struct {
+		description string
+		parts       []string
+		expected    string
+		isValid     bool
+	}{
+		{
+			"single_part",
+			[]string{"pid"},
+			"pid",
+			true,
+		},
+		{
+			"several_parts",
+			[]string{"pid", "iid", "did"},
+			"pid,iid,did",
+			true,
+		},
+		{
+			"no_parts",
+			[]string{},
+			"",
+			false,
+		},
+		{
+			"empty_part",
+			[]string{"pid", ""},
+			"",
+			false,
+		},
+		{
+			"part_with_separator",
+			[]string{"pid", "iid,did"},
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			id, err := composeId(tt.parts)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && id != tt.expected {
+				t.Fatalf("ID does not match: expected %q, got %q", tt.expected, id)
+			}
+		})
+	}
+}
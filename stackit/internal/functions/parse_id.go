@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &parseIdFunction{}
+
+// NewParseIdFunction is a helper function to simplify the provider implementation.
+func NewParseIdFunction() function.Function {
+	return &parseIdFunction{}
+}
+
+// parseIdFunction is the function implementation.
+type parseIdFunction struct{}
+
+// Metadata returns the function name.
+func (f *parseIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_id"
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *parseIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Splits the ID of a resource into its parts.",
+		MarkdownDescription: fmt.Sprintf("Splits the `id` attribute of a resource at `%s` into its parts, e.g. `provider::stackit::parse_id(stackit_postgresflex_database.example.id)[2]` returns the database ID.", core.Separator),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: fmt.Sprintf("The ID to split, e.g. `project_id%sinstance_id%sdatabase_id`.", core.Separator, core.Separator),
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run splits the given ID into its parts.
+func (f *parseIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseId(id)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parts))
+}
+
+func parseId(id string) ([]string, error) {
+	parts := strings.Split(id, core.Separator)
+	for i, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("part %d of ID %q is empty", i+1, id)
+		}
+	}
+	return parts, nil
+}
@@ -0,0 +1,64 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseId(t *testing.T) {
+	tests := []struct {
+		description string
+		id          string
+		expected    []string
+		isValid     bool
+	}{
+		{
+			"single_part",
+			"pid",
+			[]string{"pid"},
+			true,
+		},
+		{
+			"several_parts",
+			"pid,iid,did",
+			[]string{"pid", "iid", "did"},
+			true,
+		},
+		{
+			"empty_id",
+			"",
+			nil,
+			false,
+		},
+		{
+			"empty_part",
+			"pid,,did",
+			nil,
+			false,
+		},
+		{
+			"trailing_separator",
+			"pid,iid,",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			parts, err := parseId(tt.id)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(parts, tt.expected)
+				if diff != "" {
+					t.Fatalf("Parts do not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -18,13 +18,15 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/services/argus"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &credentialResource{}
-	_ resource.ResourceWithConfigure = &credentialResource{}
+	_ resource.Resource               = &credentialResource{}
+	_ resource.ResourceWithConfigure  = &credentialResource{}
+	_ resource.ResourceWithModifyPlan = &credentialResource{}
 )
 
 type Model struct {
@@ -42,7 +44,8 @@ func NewCredentialResource() resource.Resource {
 
 // credentialResource is the resource implementation.
 type credentialResource struct {
-	client *argus.APIClient
+	client       *argus.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -57,7 +60,8 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -65,15 +69,15 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 
 	var apiClient *argus.APIClient
 	var err error
-	if providerData.ArgusCustomEndpoint != "" {
-		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.ArgusCustomEndpoint),
+	if r.providerData.ArgusCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, argus.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ArgusCustomEndpoint),
 		)
 	} else {
-		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, argus.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -147,6 +151,14 @@ var (
 	}
 )
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled.
+func (r *credentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		utils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+}
+
 func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = Schema
 }
@@ -165,7 +177,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 	got, err := r.client.CreateCredentials(ctx, instanceId, projectId).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating credential", err, req.Plan)
 		return
 	}
 	err = mapFields(got.Credentials, &model)
@@ -173,6 +185,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString())
 	diags = resp.State.Set(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -217,6 +230,7 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString())
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
 	userName := model.Username.ValueString()
@@ -252,6 +266,7 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString())
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
 	userName := model.Username.ValueString()
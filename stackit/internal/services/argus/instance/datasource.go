@@ -55,12 +55,12 @@ func (d *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	}
 
 	if providerData.ArgusCustomEndpoint != "" {
-		apiClient, err = argus.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, argus.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ArgusCustomEndpoint),
 		)
 	} else {
-		apiClient, err = argus.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, argus.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -89,6 +89,10 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`instance_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the instance is associated.",
 				Required:    true,
@@ -137,6 +141,10 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Specifies Argus instance dashboard URL.",
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: "Status of the instance, e.g. `CREATE_SUCCEEDED`.",
+				Computed:    true,
+			},
 			"is_updatable": schema.BoolAttribute{
 				Description: "Specifies if the instance can be updated.",
 				Computed:    true,
@@ -31,6 +31,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/argus/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	internalUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -43,10 +44,12 @@ var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
 type Model struct {
 	Id                                 types.String `tfsdk:"id"` // needed by TF
+	ImportId                           types.String `tfsdk:"import_id"`
 	ProjectId                          types.String `tfsdk:"project_id"`
 	InstanceId                         types.String `tfsdk:"instance_id"`
 	Name                               types.String `tfsdk:"name"`
@@ -55,6 +58,7 @@ type Model struct {
 	Parameters                         types.Map    `tfsdk:"parameters"`
 	DashboardURL                       types.String `tfsdk:"dashboard_url"`
 	IsUpdatable                        types.Bool   `tfsdk:"is_updatable"`
+	Status                             types.String `tfsdk:"status"`
 	GrafanaURL                         types.String `tfsdk:"grafana_url"`
 	GrafanaPublicReadAccess            types.Bool   `tfsdk:"grafana_public_read_access"`
 	GrafanaInitialAdminPassword        types.String `tfsdk:"grafana_initial_admin_password"`
@@ -331,7 +335,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *argus.APIClient
+	client       *argus.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -346,7 +351,8 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -354,15 +360,15 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 
 	var apiClient *argus.APIClient
 	var err error
-	if providerData.ArgusCustomEndpoint != "" {
-		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.ArgusCustomEndpoint),
+	if r.providerData.ArgusCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, argus.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ArgusCustomEndpoint),
 		)
 	} else {
-		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, argus.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -393,6 +399,13 @@ var (
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the instance is associated.",
 				Required:    true,
@@ -454,6 +467,10 @@ var (
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: "Status of the instance, e.g. `CREATE_SUCCEEDED`.",
+				Computed:    true,
+			},
 			"is_updatable": schema.BoolAttribute{
 				Description: "Specifies if the instance can be updated.",
 				Computed:    true,
@@ -779,6 +796,14 @@ var (
 	}
 )
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		internalUtils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = Schema
@@ -832,12 +857,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*createPayload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating instance", err, req.Plan)
 		return
 	}
 	instanceId := createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, *instanceId, projectId).WaitWithContext(ctx)
+	waitResp, err := internalUtils.Wait(ctx, wait.CreateInstanceWaitHandler(ctx, r.client, *instanceId, projectId), "Argus instance creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -1126,10 +1151,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update existing instance
 	_, err = r.client.UpdateInstance(ctx, instanceId, projectId).UpdateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating instance", err, req.Plan)
 		return
 	}
-	waitResp, err := wait.UpdateInstanceWaitHandler(ctx, r.client, instanceId, projectId).WaitWithContext(ctx)
+	waitResp, err := internalUtils.Wait(ctx, wait.UpdateInstanceWaitHandler(ctx, r.client, instanceId, projectId), "Argus instance update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
@@ -1278,7 +1303,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, instanceId, projectId).WaitWithContext(ctx)
+	_, err = internalUtils.Wait(ctx, wait.DeleteInstanceWaitHandler(ctx, r.client, instanceId, projectId), "Argus instance deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -1328,6 +1353,7 @@ func mapFields(ctx context.Context, r *argus.GetInstanceResponse, model *Model)
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanName = types.StringPointerValue(r.PlanName)
 	model.PlanId = types.StringPointerValue(r.PlanId)
@@ -1349,6 +1375,7 @@ func mapFields(ctx context.Context, r *argus.GetInstanceResponse, model *Model)
 	}
 
 	model.IsUpdatable = types.BoolPointerValue(r.IsUpdatable)
+	model.Status = types.StringPointerValue(r.Status)
 	model.DashboardURL = types.StringPointerValue(r.DashboardUrl)
 	if r.Instance != nil {
 		i := *r.Instance
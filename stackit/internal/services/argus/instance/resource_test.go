@@ -347,6 +347,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                                 types.StringValue("pid,iid"),
+				ImportId:                           types.StringValue("pid,iid"),
 				ProjectId:                          types.StringValue("pid"),
 				InstanceId:                         types.StringValue("iid"),
 				PlanId:                             types.StringNull(),
@@ -366,6 +367,7 @@ func TestMapFields(t *testing.T) {
 				Id:         utils.Ptr("iid"),
 				Name:       utils.Ptr("name"),
 				PlanName:   utils.Ptr("plan1"),
+				Status:     utils.Ptr("CREATE_SUCCEEDED"),
 				PlanId:     utils.Ptr("planId"),
 				Parameters: &map[string]string{"key": "value"},
 				Instance: &argus.InstanceSensitiveData{
@@ -387,11 +389,13 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
 				InstanceId: types.StringValue("iid"),
 				PlanId:     types.StringValue("planId"),
 				PlanName:   types.StringValue("plan1"),
+				Status:     types.StringValue("CREATE_SUCCEEDED"),
 				Parameters: toTerraformStringMapMust(context.Background(), map[string]string{"key": "value"}),
 				ACL: types.SetValueMust(types.StringType, []attr.Value{
 					types.StringValue("1.1.1.1/32"),
@@ -425,6 +429,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
 				InstanceId: types.StringValue("iid"),
@@ -458,6 +463,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                                 types.StringValue("pid,iid"),
+				ImportId:                           types.StringValue("pid,iid"),
 				ProjectId:                          types.StringValue("pid"),
 				InstanceId:                         types.StringValue("iid"),
 				PlanId:                             types.StringNull(),
@@ -542,6 +548,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
 				InstanceId: types.StringValue("iid"),
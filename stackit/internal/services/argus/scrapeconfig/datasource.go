@@ -57,12 +57,12 @@ func (d *scrapeConfigDataSource) Configure(ctx context.Context, req datasource.C
 	}
 
 	if providerData.ArgusCustomEndpoint != "" {
-		apiClient, err = argus.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, argus.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ArgusCustomEndpoint),
 		)
 	} else {
-		apiClient, err = argus.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, argus.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -90,6 +90,10 @@ func (d *scrapeConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`,`instance_id`,`name`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the scraping job is associated.",
 				Required:    true,
@@ -32,6 +32,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/argus/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	internalUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -48,10 +49,12 @@ var (
 	_ resource.Resource                = &scrapeConfigResource{}
 	_ resource.ResourceWithConfigure   = &scrapeConfigResource{}
 	_ resource.ResourceWithImportState = &scrapeConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &scrapeConfigResource{}
 )
 
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
+	ImportId       types.String `tfsdk:"import_id"`
 	ProjectId      types.String `tfsdk:"project_id"`
 	InstanceId     types.String `tfsdk:"instance_id"`
 	Name           types.String `tfsdk:"name"`
@@ -106,7 +109,8 @@ func NewScrapeConfigResource() resource.Resource {
 
 // scrapeConfigResource is the resource implementation.
 type scrapeConfigResource struct {
-	client *argus.APIClient
+	client       *argus.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -121,7 +125,8 @@ func (r *scrapeConfigResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -129,15 +134,15 @@ func (r *scrapeConfigResource) Configure(ctx context.Context, req resource.Confi
 
 	var apiClient *argus.APIClient
 	var err error
-	if providerData.ArgusCustomEndpoint != "" {
-		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.ArgusCustomEndpoint),
+	if r.providerData.ArgusCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, argus.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ArgusCustomEndpoint),
 		)
 	} else {
-		apiClient, err = argus.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, argus.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -167,6 +172,13 @@ var (
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the scraping job is associated.",
 				Required:    true,
@@ -318,6 +330,14 @@ var (
 	}
 )
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled.
+func (r *scrapeConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		internalUtils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *scrapeConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = Schema
@@ -372,10 +392,10 @@ func (r *scrapeConfigResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	_, err = r.client.CreateScrapeConfig(ctx, instanceId, projectId).CreateScrapeConfigPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating scrape config", err, req.Plan)
 		return
 	}
-	_, err = wait.CreateScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).WaitWithContext(ctx)
+	_, err = internalUtils.Wait(ctx, wait.CreateScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId), "Argus scrape config creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Scrape config creation waiting: %v", err))
 		return
@@ -485,7 +505,7 @@ func (r *scrapeConfigResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	_, err = r.client.UpdateScrapeConfig(ctx, instanceId, scName, projectId).UpdateScrapeConfigPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape config", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating scrape config", err, req.Plan)
 		return
 	}
 	// We do not have an update status provided by the argus scrape config api, so we cannot use a waiter here, hence a simple sleep is used.
@@ -530,7 +550,7 @@ func (r *scrapeConfigResource) Delete(ctx context.Context, req resource.DeleteRe
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape config", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).WaitWithContext(ctx)
+	_, err = internalUtils.Wait(ctx, wait.DeleteScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId), "Argus scrape config deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape config", fmt.Sprintf("Scrape config deletion waiting: %v", err))
 		return
@@ -583,6 +603,7 @@ func mapFields(ctx context.Context, sc *argus.Job, model *Model) error {
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.Name = types.StringValue(scName)
 
 	model.MetricsPath = types.StringPointerValue(sc.MetricsPath)
@@ -0,0 +1,246 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/authorization"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &callerPermissionsDataSource{}
+)
+
+const projectResourceType = "project"
+
+type Model struct {
+	Id          types.String `tfsdk:"id"` // needed by TF
+	ProjectId   types.String `tfsdk:"project_id"`
+	Subject     types.String `tfsdk:"subject"`
+	Roles       types.List   `tfsdk:"roles"`
+	Permissions types.List   `tfsdk:"permissions"`
+}
+
+// NewCallerPermissionsDataSource is a helper function to simplify the provider implementation.
+func NewCallerPermissionsDataSource() datasource.DataSource {
+	return &callerPermissionsDataSource{}
+}
+
+// callerPermissionsDataSource is the data source implementation.
+type callerPermissionsDataSource struct {
+	client      *authorization.APIClient
+	callerEmail string
+}
+
+// Metadata returns the data source type name.
+func (d *callerPermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorization_caller_permissions"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *callerPermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *authorization.APIClient
+	var err error
+	if providerData.AuthorizationCustomEndpoint != "" {
+		ctx = tflog.SetField(ctx, "authorization_custom_endpoint", providerData.AuthorizationCustomEndpoint)
+		apiClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.AuthorizationCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	d.client = apiClient
+	d.callerEmail = providerData.CallerEmail
+	tflog.Info(ctx, "Authorization caller permissions client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *callerPermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":        "Authorization caller permissions data source schema. Returns the roles and effective permissions of the caller on a project, so that missing roles can be detected, e.g. with a `precondition`, before resources are applied.",
+		"id":          "Terraform's internal data source ID. It is structured as \"`project_id`,`subject`\".",
+		"project_id":  "STACKIT project ID.",
+		"subject":     "Email of the user or service account to list the permissions of. Defaults to the service account the provider is authenticated with. Must be set if the email can't be derived from the credentials, e.g. for tokens without an `email` claim.",
+		"roles":       "The roles assigned to the subject directly on the project. Roles inherited from the organization or a folder are not included.",
+		"permissions": "The effective permissions of the subject on the project, including the ones inherited from the organization or a folder.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				Description: descriptions["subject"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"roles": schema.ListAttribute{
+				Description: descriptions["roles"],
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"permissions": schema.ListAttribute{
+				Description: descriptions["permissions"],
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *callerPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	subject := d.callerEmail
+	if !(model.Subject.IsUnknown() || model.Subject.IsNull()) {
+		subject = model.Subject.ValueString()
+	}
+	if subject == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading caller permissions", "The email of the caller can't be derived from the credentials of the provider, please set \"subject\"")
+		return
+	}
+	ctx = tflog.SetField(ctx, "subject", subject)
+
+	membershipsResp, err := d.client.ListUserMemberships(ctx, subject).ResourceType(projectResourceType).ResourceId(projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading caller permissions", fmt.Sprintf("Calling API to list memberships: %v", err))
+		return
+	}
+
+	permissionsResp, err := d.client.ListUserPermissions(ctx, subject).ResourceType(projectResourceType).Resource(projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading caller permissions", fmt.Sprintf("Calling API to list permissions: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, membershipsResp, permissionsResp, subject, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading caller permissions", fmt.Sprintf("Processing API response: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Authorization caller permissions read")
+}
+
+func mapFields(ctx context.Context, membershipsResp *authorization.ListUserMembershipsResponse, permissionsResp *authorization.ListUserPermissionsResponse, subject string, model *Model) error {
+	if membershipsResp == nil || permissionsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	projectId := model.ProjectId.ValueString()
+
+	roles := []string{}
+	if membershipsResp.Items != nil {
+		for _, membership := range *membershipsResp.Items {
+			if membership.ResourceId != nil && *membership.ResourceId != projectId {
+				continue
+			}
+			if membership.Role == nil {
+				return fmt.Errorf("membership role not present")
+			}
+			if !slices.Contains(roles, *membership.Role) {
+				roles = append(roles, *membership.Role)
+			}
+		}
+	}
+	sort.Strings(roles)
+
+	permissions := []string{}
+	if permissionsResp.Items != nil {
+		for _, userPermission := range *permissionsResp.Items {
+			if userPermission.ResourceId != nil && *userPermission.ResourceId != projectId {
+				continue
+			}
+			if userPermission.Permissions == nil {
+				continue
+			}
+			for _, permission := range *userPermission.Permissions {
+				if permission.Name == nil {
+					return fmt.Errorf("permission name not present")
+				}
+				if !slices.Contains(permissions, *permission.Name) {
+					permissions = append(permissions, *permission.Name)
+				}
+			}
+		}
+	}
+	sort.Strings(permissions)
+
+	rolesTF, diags := types.ListValueFrom(ctx, types.StringType, roles)
+	if diags.HasError() {
+		return fmt.Errorf("converting roles: %w", core.DiagsToError(diags))
+	}
+	permissionsTF, diags := types.ListValueFrom(ctx, types.StringType, permissions)
+	if diags.HasError() {
+		return fmt.Errorf("converting permissions: %w", core.DiagsToError(diags))
+	}
+
+	model.Id = types.StringValue(strings.Join([]string{projectId, subject}, core.Separator))
+	model.Subject = types.StringValue(subject)
+	model.Roles = rolesTF
+	model.Permissions = permissionsTF
+	return nil
+}
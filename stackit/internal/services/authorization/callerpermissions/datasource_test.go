@@ -0,0 +1,149 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/authorization"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description     string
+		membershipsResp *authorization.ListUserMembershipsResponse
+		permissionsResp *authorization.ListUserPermissionsResponse
+		expected        Model
+		isValid         bool
+	}{
+		{
+			"no_roles_and_permissions",
+			&authorization.ListUserMembershipsResponse{},
+			&authorization.ListUserPermissionsResponse{},
+			Model{
+				Id:          types.StringValue("pid,sa@sa.stackit.cloud"),
+				ProjectId:   types.StringValue("pid"),
+				Subject:     types.StringValue("sa@sa.stackit.cloud"),
+				Roles:       types.ListValueMust(types.StringType, []attr.Value{}),
+				Permissions: types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&authorization.ListUserMembershipsResponse{
+				Items: &[]authorization.UserMembership{
+					{
+						ResourceId:   utils.Ptr("pid"),
+						ResourceType: utils.Ptr("project"),
+						Role:         utils.Ptr("reader"),
+						Subject:      utils.Ptr("sa@sa.stackit.cloud"),
+					},
+					{
+						ResourceId:   utils.Ptr("pid"),
+						ResourceType: utils.Ptr("project"),
+						Role:         utils.Ptr("editor"),
+						Subject:      utils.Ptr("sa@sa.stackit.cloud"),
+					},
+					{
+						ResourceId:   utils.Ptr("other-pid"),
+						ResourceType: utils.Ptr("project"),
+						Role:         utils.Ptr("owner"),
+						Subject:      utils.Ptr("sa@sa.stackit.cloud"),
+					},
+				},
+			},
+			&authorization.ListUserPermissionsResponse{
+				Items: &[]authorization.UserPermission{
+					{
+						ResourceId:   utils.Ptr("pid"),
+						ResourceType: utils.Ptr("project"),
+						Permissions: &[]authorization.ExistingPermission{
+							{Name: utils.Ptr("ske.cluster.write")},
+							{Name: utils.Ptr("dns.zone.read")},
+							{Name: utils.Ptr("ske.cluster.write")},
+						},
+					},
+					{
+						ResourceId:   utils.Ptr("other-pid"),
+						ResourceType: utils.Ptr("project"),
+						Permissions: &[]authorization.ExistingPermission{
+							{Name: utils.Ptr("project.owner")},
+						},
+					},
+				},
+			},
+			Model{
+				Id:        types.StringValue("pid,sa@sa.stackit.cloud"),
+				ProjectId: types.StringValue("pid"),
+				Subject:   types.StringValue("sa@sa.stackit.cloud"),
+				Roles: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("editor"),
+					types.StringValue("reader"),
+				}),
+				Permissions: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("dns.zone.read"),
+					types.StringValue("ske.cluster.write"),
+				}),
+			},
+			true,
+		},
+		{
+			"no_role",
+			&authorization.ListUserMembershipsResponse{
+				Items: &[]authorization.UserMembership{
+					{
+						ResourceId: utils.Ptr("pid"),
+					},
+				},
+			},
+			&authorization.ListUserPermissionsResponse{},
+			Model{},
+			false,
+		},
+		{
+			"no_permission_name",
+			&authorization.ListUserMembershipsResponse{},
+			&authorization.ListUserPermissionsResponse{
+				Items: &[]authorization.UserPermission{
+					{
+						ResourceId:  utils.Ptr("pid"),
+						Permissions: &[]authorization.ExistingPermission{{}},
+					},
+				},
+			},
+			Model{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(context.Background(), tt.membershipsResp, tt.permissionsResp, "sa@sa.stackit.cloud", model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,487 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/authorization"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectIAMResource{}
+	_ resource.ResourceWithConfigure   = &projectIAMResource{}
+	_ resource.ResourceWithImportState = &projectIAMResource{}
+)
+
+const (
+	projectResourceType = "project"
+	ownerRole           = "owner"
+)
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ImportId  types.String `tfsdk:"import_id"`
+	ProjectId types.String `tfsdk:"project_id"`
+	Members   types.Set    `tfsdk:"members"`
+}
+
+// Struct corresponding to Model.Members[i]
+type member struct {
+	Role    types.String `tfsdk:"role"`
+	Subject types.String `tfsdk:"subject"`
+}
+
+// Types corresponding to member
+var memberTypes = map[string]attr.Type{
+	"role":    types.StringType,
+	"subject": types.StringType,
+}
+
+// ownerValidator checks that at least one of the members has the owner role, so that the project isn't left without an owner.
+// The check is skipped if the role of a member is not known yet.
+type ownerValidator struct{}
+
+var _ validator.Set = ownerValidator{}
+
+func (v ownerValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("at least one member must have the %q role", ownerRole)
+}
+
+func (v ownerValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ownerValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) { // nolint:gocritic // function signature required by Terraform
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	for _, element := range req.ConfigValue.Elements() {
+		memberTF, ok := element.(types.Object)
+		if !ok || memberTF.IsUnknown() {
+			return
+		}
+		role, ok := memberTF.Attributes()["role"].(types.String)
+		if !ok || role.IsUnknown() || role.ValueString() == ownerRole {
+			return
+		}
+	}
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		req.ConfigValue.String(),
+	))
+}
+
+// NewProjectIAMResource is a helper function to simplify the provider implementation.
+func NewProjectIAMResource() resource.Resource {
+	return &projectIAMResource{}
+}
+
+// projectIAMResource is the resource implementation.
+type projectIAMResource struct {
+	client *authorization.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *projectIAMResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorization_project_iam"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectIAMResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *authorization.APIClient
+	var err error
+	if providerData.AuthorizationCustomEndpoint != "" {
+		ctx = tflog.SetField(ctx, "authorization_custom_endpoint", providerData.AuthorizationCustomEndpoint)
+		apiClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.AuthorizationCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "Authorization project IAM client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *projectIAMResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":            "Authorization project IAM resource schema. Authoritatively manages the role assignments of a project: on every apply, assignments that are not declared in `members` are removed from the project. Only one such resource should exist per project. Make sure that the service account used by Terraform keeps the permissions it needs, otherwise it will lock itself out of the project. Destroying the resource leaves the current role assignments in place.",
+		"id":              "Terraform's internal resource ID. It is structured as \"`project_id`\".",
+		"import_id":       "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+		"project_id":      "STACKIT project ID.",
+		"members":         "The complete set of role assignments of the project. At least one subject needs to have the `owner` role.",
+		"members.role":    "The role of the member in the project. Possible values include, but are not limited to: `owner`, `editor`, `reader`.",
+		"members.subject": "Unique identifier of the user, service account or client. This is usually the email address for users or service accounts, and the name in case of clients.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Description: descriptions["members"],
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					ownerValidator{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: descriptions["members.role"],
+							Required:    true,
+							Validators: []validator.String{
+								validate.NonLegacyProjectRole(),
+							},
+						},
+						"subject": schema.StringAttribute{
+							Description: descriptions["members.subject"],
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create reconciles the role assignments of the project with the declared members and sets the initial Terraform state.
+func (r *projectIAMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	err := r.reconcileMembers(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project IAM", err.Error())
+		return
+	}
+
+	membersResp, err := r.client.ListMembers(ctx, projectResourceType, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project IAM", fmt.Sprintf("Calling API for updated data: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(membersResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project IAM", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Authorization project IAM created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+// All role assignments of the project are read, so that assignments made outside of Terraform show up as drift.
+func (r *projectIAMResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	membersResp, err := r.client.ListMembers(ctx, projectResourceType, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading project IAM", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(membersResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading project IAM", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Authorization project IAM read")
+}
+
+// Update reconciles the role assignments of the project with the declared members and sets the updated Terraform state on success.
+func (r *projectIAMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	err := r.reconcileMembers(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating project IAM", err.Error())
+		return
+	}
+
+	membersResp, err := r.client.ListMembers(ctx, projectResourceType, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating project IAM", fmt.Sprintf("Calling API for updated data: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(membersResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating project IAM", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Authorization project IAM updated")
+}
+
+// Delete removes the resource from the Terraform state.
+// The role assignments are kept, since removing all of them would leave the project without an owner.
+func (r *projectIAMResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+
+	core.LogAndAddWarning(ctx, &resp.Diagnostics, "Deleting project IAM", "Deleting this resource only removes it from the Terraform state. The current role assignments of the project are kept.")
+	tflog.Info(ctx, "Authorization project IAM deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id
+func (r *projectIAMResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+	if len(idParts) != 1 || idParts[0] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing project IAM",
+			fmt.Sprintf("Expected import identifier with format [project_id], got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+	tflog.Info(ctx, "Authorization project IAM state imported")
+}
+
+// reconcileMembers adds the declared role assignments which are missing in the project and removes the ones which aren't declared.
+// Assignments are added before any is removed, so that the project is never left without an owner.
+func (r *projectIAMResource) reconcileMembers(ctx context.Context, model *Model) error {
+	projectId := model.ProjectId.ValueString()
+
+	desired, err := toMembers(ctx, model)
+	if err != nil {
+		return fmt.Errorf("creating API payload: %w", err)
+	}
+	membersResp, err := r.client.ListMembers(ctx, projectResourceType, projectId).Execute()
+	if err != nil {
+		return fmt.Errorf("calling API to get current members: %w", err)
+	}
+	var current []authorization.Member
+	if membersResp != nil && membersResp.Members != nil {
+		current = *membersResp.Members
+	}
+
+	toAdd, toRemove := computeMembersDiff(desired, current)
+	if len(toAdd) > 0 {
+		_, err = r.client.AddMembers(ctx, projectId).AddMembersPayload(authorization.AddMembersPayload{
+			Members:      &toAdd,
+			ResourceType: utils.Ptr(projectResourceType),
+		}).Execute()
+		if err != nil {
+			return fmt.Errorf("calling API to add members: %w", err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Added %d role assignments", len(toAdd)))
+	}
+	if len(toRemove) > 0 {
+		_, err = r.client.RemoveMembers(ctx, projectId).RemoveMembersPayload(authorization.RemoveMembersPayload{
+			Members:      &toRemove,
+			ResourceType: utils.Ptr(projectResourceType),
+		}).Execute()
+		if err != nil {
+			return fmt.Errorf("calling API to remove members: %w", err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Removed %d role assignments", len(toRemove)))
+	}
+	return nil
+}
+
+// computeMembersDiff returns the members which have to be added to and removed from current to get desired
+func computeMembersDiff(desired, current []authorization.Member) (toAdd, toRemove []authorization.Member) {
+	desiredIds := map[string]bool{}
+	for _, m := range desired {
+		desiredIds[memberId(m)] = true
+	}
+	currentIds := map[string]bool{}
+	for _, m := range current {
+		currentIds[memberId(m)] = true
+	}
+
+	toAdd = []authorization.Member{}
+	for _, m := range desired {
+		if !currentIds[memberId(m)] {
+			toAdd = append(toAdd, m)
+		}
+	}
+	toRemove = []authorization.Member{}
+	for _, m := range current {
+		if !desiredIds[memberId(m)] {
+			toRemove = append(toRemove, m)
+		}
+	}
+	return toAdd, toRemove
+}
+
+// Internal representation of a member, used to compare role assignments
+func memberId(m authorization.Member) string {
+	var subject, role string
+	if m.Subject != nil {
+		subject = *m.Subject
+	}
+	if m.Role != nil {
+		role = *m.Role
+	}
+	return fmt.Sprintf("%s,%s", subject, role)
+}
+
+func mapFields(membersResp *authorization.ListMembersResponse, model *Model) error {
+	if membersResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = types.StringValue(model.ProjectId.ValueString())
+	model.ImportId = model.Id
+
+	members := []authorization.Member{}
+	if membersResp.Members != nil {
+		members = append(members, *membersResp.Members...)
+	}
+	membersList := []attr.Value{}
+	for _, m := range members {
+		memberTF, diags := types.ObjectValue(memberTypes, map[string]attr.Value{
+			"role":    types.StringPointerValue(m.Role),
+			"subject": types.StringPointerValue(m.Subject),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping member: %w", core.DiagsToError(diags))
+		}
+		membersList = append(membersList, memberTF)
+	}
+	membersTF, diags := types.SetValue(types.ObjectType{AttrTypes: memberTypes}, membersList)
+	if diags.HasError() {
+		return fmt.Errorf("mapping members: %w", core.DiagsToError(diags))
+	}
+	model.Members = membersTF
+	return nil
+}
+
+func toMembers(ctx context.Context, model *Model) ([]authorization.Member, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if model.Members.IsNull() || model.Members.IsUnknown() {
+		return nil, fmt.Errorf("members are null or unknown")
+	}
+
+	membersModel := []member{}
+	diags := model.Members.ElementsAs(ctx, &membersModel, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("processing members: %w", core.DiagsToError(diags))
+	}
+
+	members := []authorization.Member{}
+	for _, m := range membersModel {
+		members = append(members, authorization.Member{
+			Role:    m.Role.ValueStringPointer(),
+			Subject: m.Subject.ValueStringPointer(),
+		})
+	}
+	return members, nil
+}
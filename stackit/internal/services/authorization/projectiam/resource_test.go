@@ -0,0 +1,269 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/authorization"
+)
+
+func memberValue(role, subject string) attr.Value {
+	return types.ObjectValueMust(memberTypes, map[string]attr.Value{
+		"role":    types.StringValue(role),
+		"subject": types.StringValue(subject),
+	})
+}
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *authorization.ListMembersResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"no_members",
+			&authorization.ListMembersResponse{},
+			Model{
+				Id:        types.StringValue("pid"),
+				ImportId:  types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Members:   types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&authorization.ListMembersResponse{
+				Members: &[]authorization.Member{
+					{
+						Role:    utils.Ptr("reader"),
+						Subject: utils.Ptr("reader@example.com"),
+					},
+					{
+						Role:    utils.Ptr("owner"),
+						Subject: utils.Ptr("owner@example.com"),
+					},
+				},
+			},
+			Model{
+				Id:        types.StringValue("pid"),
+				ImportId:  types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Members: types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+					memberValue("owner", "owner@example.com"),
+					memberValue("reader", "reader@example.com"),
+				}),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToMembers(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    []authorization.Member
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&Model{
+				Members: types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+					memberValue("owner", "owner@example.com"),
+				}),
+			},
+			[]authorization.Member{
+				{
+					Role:    utils.Ptr("owner"),
+					Subject: utils.Ptr("owner@example.com"),
+				},
+			},
+			true,
+		},
+		{
+			"null_members",
+			&Model{
+				Members: types.SetNull(types.ObjectType{AttrTypes: memberTypes}),
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toMembers(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeMembersDiff(t *testing.T) {
+	owner := authorization.Member{Role: utils.Ptr("owner"), Subject: utils.Ptr("owner@example.com")}
+	reader := authorization.Member{Role: utils.Ptr("reader"), Subject: utils.Ptr("reader@example.com")}
+	editor := authorization.Member{Role: utils.Ptr("editor"), Subject: utils.Ptr("reader@example.com")}
+
+	tests := []struct {
+		description      string
+		desired          []authorization.Member
+		current          []authorization.Member
+		expectedToAdd    []authorization.Member
+		expectedToRemove []authorization.Member
+	}{
+		{
+			"no_changes",
+			[]authorization.Member{owner, reader},
+			[]authorization.Member{reader, owner},
+			[]authorization.Member{},
+			[]authorization.Member{},
+		},
+		{
+			"add_member",
+			[]authorization.Member{owner, reader},
+			[]authorization.Member{owner},
+			[]authorization.Member{reader},
+			[]authorization.Member{},
+		},
+		{
+			"remove_undeclared_member",
+			[]authorization.Member{owner},
+			[]authorization.Member{owner, reader},
+			[]authorization.Member{},
+			[]authorization.Member{reader},
+		},
+		{
+			"change_role",
+			[]authorization.Member{owner, editor},
+			[]authorization.Member{owner, reader},
+			[]authorization.Member{editor},
+			[]authorization.Member{reader},
+		},
+		{
+			"empty_current",
+			[]authorization.Member{owner},
+			nil,
+			[]authorization.Member{owner},
+			[]authorization.Member{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			toAdd, toRemove := computeMembersDiff(tt.desired, tt.current)
+			diff := cmp.Diff(toAdd, tt.expectedToAdd)
+			if diff != "" {
+				t.Fatalf("Members to add do not match: %s", diff)
+			}
+			diff = cmp.Diff(toRemove, tt.expectedToRemove)
+			if diff != "" {
+				t.Fatalf("Members to remove do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnerValidator(t *testing.T) {
+	tests := []struct {
+		description string
+		input       types.Set
+		isValid     bool
+	}{
+		{
+			"owner",
+			types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+				memberValue("reader", "reader@example.com"),
+				memberValue("owner", "owner@example.com"),
+			}),
+			true,
+		},
+		{
+			"no_owner",
+			types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+				memberValue("reader", "reader@example.com"),
+				memberValue("editor", "editor@example.com"),
+			}),
+			false,
+		},
+		{
+			"unknown_role",
+			types.SetValueMust(types.ObjectType{AttrTypes: memberTypes}, []attr.Value{
+				memberValue("reader", "reader@example.com"),
+				types.ObjectValueMust(memberTypes, map[string]attr.Value{
+					"role":    types.StringUnknown(),
+					"subject": types.StringValue("owner@example.com"),
+				}),
+			}),
+			true,
+		},
+		{
+			"unknown_members",
+			types.SetUnknown(types.ObjectType{AttrTypes: memberTypes}),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			req := validator.SetRequest{
+				Path:        path.Root("members"),
+				ConfigValue: tt.input,
+			}
+			resp := validator.SetResponse{}
+			ownerValidator{}.ValidateSet(context.Background(), req, &resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
@@ -52,12 +52,12 @@ func (d *recordSetDataSource) Configure(ctx context.Context, req datasource.Conf
 	var apiClient *dns.APIClient
 	var err error
 	if providerData.DnsCustomEndpoint != "" {
-		apiClient, err = dns.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.DnsCustomEndpoint),
 		)
 	} else {
-		apiClient, err = dns.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 		)
 	}
@@ -80,6 +80,10 @@ func (d *recordSetDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`,`zone_id`,`record_set_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the dns record set is associated.",
 				Required:    true,
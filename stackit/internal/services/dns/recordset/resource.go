@@ -31,10 +31,12 @@ var (
 	_ resource.Resource                = &recordSetResource{}
 	_ resource.ResourceWithConfigure   = &recordSetResource{}
 	_ resource.ResourceWithImportState = &recordSetResource{}
+	_ resource.ResourceWithModifyPlan  = &recordSetResource{}
 )
 
 type Model struct {
 	Id          types.String `tfsdk:"id"` // needed by TF
+	ImportId    types.String `tfsdk:"import_id"`
 	RecordSetId types.String `tfsdk:"record_set_id"`
 	ZoneId      types.String `tfsdk:"zone_id"`
 	ProjectId   types.String `tfsdk:"project_id"`
@@ -56,7 +58,8 @@ func NewRecordSetResource() resource.Resource {
 
 // recordSetResource is the resource implementation.
 type recordSetResource struct {
-	client *dns.APIClient
+	client       *dns.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -71,7 +74,8 @@ func (r *recordSetResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -79,14 +83,14 @@ func (r *recordSetResource) Configure(ctx context.Context, req resource.Configur
 
 	var apiClient *dns.APIClient
 	var err error
-	if providerData.DnsCustomEndpoint != "" {
-		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.DnsCustomEndpoint),
+	if r.providerData.DnsCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, dns.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.DnsCustomEndpoint),
 		)
 	} else {
-		apiClient, err = dns.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
+		apiClient, err = core.NewAPIClient(&r.providerData, dns.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
 		)
 	}
 
@@ -99,6 +103,59 @@ func (r *recordSetResource) Configure(ctx context.Context, req resource.Configur
 	tflog.Info(ctx, "DNS record set client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to warn about an already existing record set with the same name and type when planning its creation.
+func (r *recordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if !r.providerData.EnablePlanTimeChecks || !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planModel.ProjectId.IsUnknown() || planModel.ZoneId.IsUnknown() || planModel.Name.IsUnknown() || planModel.Type.IsUnknown() {
+		return
+	}
+	projectId := planModel.ProjectId.ValueString()
+	zoneId := planModel.ZoneId.ValueString()
+	recordSetType := planModel.Type.ValueString()
+
+	zoneResp, err := r.client.GetZone(ctx, projectId, zoneId).Execute()
+	if err != nil || zoneResp == nil || zoneResp.Zone == nil || zoneResp.Zone.DnsName == nil {
+		// The zone may not exist yet, any other error will surface during apply
+		tflog.Debug(ctx, fmt.Sprintf("Plan-time check for record set: getting zone: %v", err))
+		return
+	}
+	fqdn := recordSetFQDN(planModel.Name.ValueString(), *zoneResp.Zone.DnsName)
+
+	recordSetsResp, err := r.client.ListRecordSets(ctx, projectId, zoneId).NameEq(fqdn).TypeEq(recordSetType).StateNeq(wait.DeleteSuccess).Execute()
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Plan-time check for record set %q: %v", fqdn, err))
+		return
+	}
+	if recordSetsResp != nil && recordSetsResp.RrSets != nil && len(*recordSetsResp.RrSets) > 0 {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Record set already exists",
+			fmt.Sprintf("A record set with name %q and type %q already exists in zone %q, creating it will fail. Import the existing record set or choose a different name.", fqdn, recordSetType, zoneId))
+	}
+}
+
+// recordSetFQDN returns the fully qualified domain name, including the trailing dot,
+// of a record set with the given name in the zone with the given DNS name.
+// The name can either be relative to the zone or already fully qualified.
+func recordSetFQDN(name, zoneDnsName string) string {
+	zoneDnsName = strings.TrimSuffix(zoneDnsName, ".")
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || name == "@" {
+		return zoneDnsName + "."
+	}
+	if name == zoneDnsName || strings.HasSuffix(name, "."+zoneDnsName) {
+		return name + "."
+	}
+	return name + "." + zoneDnsName + "."
+}
+
 // Schema defines the schema for the resource.
 func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -111,6 +168,13 @@ func (r *recordSetResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the dns record set is associated.",
 				Required:    true,
@@ -236,12 +300,22 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 	// Create new recordset
 	recordSetResp, err := r.client.CreateRecordSet(ctx, projectId, zoneId).CreateRecordSetPayload(*payload).Execute()
 	if err != nil || recordSetResp.Rrset == nil || recordSetResp.Rrset.Id == nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record set", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating record set", err, req.Plan)
 		return
 	}
 	ctx = tflog.SetField(ctx, "record_set_id", *recordSetResp.Rrset.Id)
 
-	waitResp, err := wait.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id).WaitWithContext(ctx)
+	// The record set is not always readable right after its creation and the waiter fails on a not found error
+	err = utils.ReadAfterWrite(ctx, func() (bool, error) {
+		_, err := r.client.GetRecordSet(ctx, projectId, zoneId, *recordSetResp.Rrset.Id).Execute()
+		return err == nil, err
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record set", fmt.Sprintf("Reading created record set: %v", err))
+		return
+	}
+
+	waitResp, err := utils.Wait(ctx, wait.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id), "DNS record set creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record set", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -320,8 +394,15 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
 	ctx = tflog.SetField(ctx, "record_set_id", recordSetId)
 
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model)
+	payload, err := toUpdatePayload(&model, &stateModel)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -332,7 +413,7 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", err.Error())
 		return
 	}
-	waitResp, err := wait.PartialUpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.PartialUpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId), "DNS record set update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", fmt.Sprintf("Instance update waiting: %v", err))
 		return
@@ -373,7 +454,7 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", fmt.Sprintf("Calling API: %v", err))
 	}
-	_, err = wait.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId), "DNS record set deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -448,6 +529,7 @@ func mapFields(ctx context.Context, recordSetResp *dns.RecordSetResponse, model
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.RecordSetId = types.StringPointerValue(recordSet.Id)
 	model.Active = types.BoolPointerValue(recordSet.Active)
 	model.Comment = types.StringPointerValue(recordSet.Comment)
@@ -487,26 +569,36 @@ func toCreatePayload(model *Model) (*dns.CreateRecordSetPayload, error) {
 	}, nil
 }
 
-func toUpdatePayload(model *Model) (*dns.PartialUpdateRecordSetPayload, error) {
+// toUpdatePayload creates the payload to update the record set. If the current state is given, only the fields
+// which changed are included, e.g. rotating the records only patches the records of the record set.
+// The API replaces the records in a single operation, so there is no time window where the record set is missing.
+func toUpdatePayload(model, state *Model) (*dns.PartialUpdateRecordSetPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
 	}
 
-	records := []dns.RecordPayload{}
-	for i, record := range model.Records.Elements() {
-		recordString, ok := record.(types.String)
-		if !ok {
-			return nil, fmt.Errorf("expected record at index %d to be of type %T, got %T", i, types.String{}, record)
+	payload := &dns.PartialUpdateRecordSetPayload{}
+	if state == nil || !model.Records.Equal(state.Records) {
+		records := []dns.RecordPayload{}
+		for i, record := range model.Records.Elements() {
+			recordString, ok := record.(types.String)
+			if !ok {
+				return nil, fmt.Errorf("expected record at index %d to be of type %T, got %T", i, types.String{}, record)
+			}
+			records = append(records, dns.RecordPayload{
+				Content: conversion.StringValueToPointer(recordString),
+			})
 		}
-		records = append(records, dns.RecordPayload{
-			Content: conversion.StringValueToPointer(recordString),
-		})
+		payload.Records = &records
 	}
-
-	return &dns.PartialUpdateRecordSetPayload{
-		Comment: conversion.StringValueToPointer(model.Comment),
-		Name:    conversion.StringValueToPointer(model.Name),
-		Records: &records,
-		Ttl:     conversion.Int64ValueToPointer(model.TTL),
-	}, nil
+	if state == nil || !model.Comment.Equal(state.Comment) {
+		payload.Comment = conversion.StringValueToPointer(model.Comment)
+	}
+	if state == nil || !model.Name.Equal(state.Name) {
+		payload.Name = conversion.StringValueToPointer(model.Name)
+	}
+	if state == nil || !model.TTL.Equal(state.TTL) {
+		payload.Ttl = conversion.Int64ValueToPointer(model.TTL)
+	}
+	return payload, nil
 }
@@ -32,6 +32,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("pid,zid,rid"),
+				ImportId:    types.StringValue("pid,zid,rid"),
 				RecordSetId: types.StringValue("rid"),
 				ZoneId:      types.StringValue("zid"),
 				ProjectId:   types.StringValue("pid"),
@@ -71,6 +72,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("pid,zid,rid"),
+				ImportId:    types.StringValue("pid,zid,rid"),
 				RecordSetId: types.StringValue("rid"),
 				ZoneId:      types.StringValue("zid"),
 				ProjectId:   types.StringValue("pid"),
@@ -117,6 +119,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("pid,zid,rid"),
+				ImportId:    types.StringValue("pid,zid,rid"),
 				RecordSetId: types.StringValue("rid"),
 				ZoneId:      types.StringValue("zid"),
 				ProjectId:   types.StringValue("pid"),
@@ -157,6 +160,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("pid,zid,rid"),
+				ImportId:    types.StringValue("pid,zid,rid"),
 				RecordSetId: types.StringValue("rid"),
 				ZoneId:      types.StringValue("zid"),
 				ProjectId:   types.StringValue("pid"),
@@ -299,12 +303,14 @@ func TestToUpdatePayload(t *testing.T) {
 	tests := []struct {
 		description string
 		input       *Model
+		state       *Model
 		expected    *dns.PartialUpdateRecordSetPayload
 		isValid     bool
 	}{
 		{
 			"default_values",
 			&Model{},
+			nil,
 			&dns.PartialUpdateRecordSetPayload{
 				Records: &[]dns.RecordPayload{},
 			},
@@ -321,6 +327,7 @@ func TestToUpdatePayload(t *testing.T) {
 				}),
 				TTL: types.Int64Value(1),
 			},
+			nil,
 			&dns.PartialUpdateRecordSetPayload{
 				Comment: utils.Ptr("comment"),
 				Name:    utils.Ptr("name"),
@@ -340,6 +347,7 @@ func TestToUpdatePayload(t *testing.T) {
 				Records: types.ListValueMust(types.StringType, nil),
 				TTL:     types.Int64Value(2123456789),
 			},
+			nil,
 			&dns.PartialUpdateRecordSetPayload{
 				Comment: nil,
 				Name:    utils.Ptr(""),
@@ -348,16 +356,69 @@ func TestToUpdatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"only_records_changed",
+			&Model{
+				Comment: types.StringValue("comment"),
+				Name:    types.StringValue("name"),
+				Records: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("record_2"),
+					types.StringValue("record_3"),
+				}),
+				TTL: types.Int64Value(1),
+			},
+			&Model{
+				Comment: types.StringValue("comment"),
+				Name:    types.StringValue("name"),
+				Records: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("record_1"),
+					types.StringValue("record_2"),
+				}),
+				TTL: types.Int64Value(1),
+			},
+			&dns.PartialUpdateRecordSetPayload{
+				Records: &[]dns.RecordPayload{
+					{Content: utils.Ptr("record_2")},
+					{Content: utils.Ptr("record_3")},
+				},
+			},
+			true,
+		},
+		{
+			"only_ttl_and_comment_changed",
+			&Model{
+				Comment: types.StringValue("new comment"),
+				Name:    types.StringValue("name"),
+				Records: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("record_1"),
+				}),
+				TTL: types.Int64Value(2),
+			},
+			&Model{
+				Comment: types.StringValue("comment"),
+				Name:    types.StringValue("name"),
+				Records: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("record_1"),
+				}),
+				TTL: types.Int64Value(1),
+			},
+			&dns.PartialUpdateRecordSetPayload{
+				Comment: utils.Ptr("new comment"),
+				Ttl:     utils.Ptr(int64(2)),
+			},
+			true,
+		},
 		{
 			"nil_model",
 			nil,
 			nil,
+			nil,
 			false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			output, err := toUpdatePayload(tt.input)
+			output, err := toUpdatePayload(tt.input, tt.state)
 			if !tt.isValid && err == nil {
 				t.Fatalf("Should have failed")
 			}
@@ -373,3 +434,63 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordSetFQDN(t *testing.T) {
+	tests := []struct {
+		description string
+		name        string
+		zoneDnsName string
+		expected    string
+	}{
+		{
+			"relative_name",
+			"www",
+			"example.com",
+			"www.example.com.",
+		},
+		{
+			"relative_name_zone_trailing_dot",
+			"www",
+			"example.com.",
+			"www.example.com.",
+		},
+		{
+			"fully_qualified_name",
+			"www.example.com",
+			"example.com",
+			"www.example.com.",
+		},
+		{
+			"fully_qualified_name_trailing_dot",
+			"www.example.com.",
+			"example.com",
+			"www.example.com.",
+		},
+		{
+			"zone_apex",
+			"example.com",
+			"example.com",
+			"example.com.",
+		},
+		{
+			"at_sign",
+			"@",
+			"example.com",
+			"example.com.",
+		},
+		{
+			"name_ending_like_zone",
+			"wwwexample.com",
+			"example.com",
+			"wwwexample.com.example.com.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := recordSetFQDN(tt.name, tt.zoneDnsName)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
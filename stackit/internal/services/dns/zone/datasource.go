@@ -52,12 +52,12 @@ func (d *zoneDataSource) Configure(ctx context.Context, req datasource.Configure
 	}
 
 	if providerData.DnsCustomEndpoint != "" {
-		apiClient, err = dns.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.DnsCustomEndpoint),
 		)
 	} else {
-		apiClient, err = dns.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 		)
 	}
@@ -79,6 +79,10 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`,`zone_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the dns zone is associated.",
 				Required:    true,
@@ -139,6 +143,11 @@ func (d *zoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "Primary name server. FQDN.",
 				Computed:    true,
 			},
+			"name_servers": schema.ListAttribute{
+				Description: "The authoritative name servers of the zone, i.e. the records of the `NS` record set at the apex of the zone, without the trailing dot. These are the name servers to be configured at the registrar of the domain.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"primaries": schema.ListAttribute{
 				Description: `Primary name server for secondary zone.`,
 				Computed:    true,
@@ -205,6 +214,11 @@ func (d *zoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NameServers, err = readNameServers(ctx, d.client, projectId, zoneId, model.DnsName.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone", fmt.Sprintf("Reading name servers: %v", err))
+		return
+	}
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
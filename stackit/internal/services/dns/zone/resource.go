@@ -4,20 +4,26 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
@@ -33,11 +39,13 @@ import (
 var (
 	_ resource.Resource                = &zoneResource{}
 	_ resource.ResourceWithConfigure   = &zoneResource{}
+	_ resource.ResourceWithModifyPlan  = &zoneResource{}
 	_ resource.ResourceWithImportState = &zoneResource{}
 )
 
 type Model struct {
 	Id                types.String `tfsdk:"id"` // needed by TF
+	ImportId          types.String `tfsdk:"import_id"`
 	ZoneId            types.String `tfsdk:"zone_id"`
 	ProjectId         types.String `tfsdk:"project_id"`
 	Name              types.String `tfsdk:"name"`
@@ -52,6 +60,7 @@ type Model struct {
 	NegativeCache     types.Int64  `tfsdk:"negative_cache"`
 	PrimaryNameServer types.String `tfsdk:"primary_name_server"`
 	Primaries         types.List   `tfsdk:"primaries"`
+	NameServers       types.List   `tfsdk:"name_servers"`
 	RecordCount       types.Int64  `tfsdk:"record_count"`
 	RefreshTime       types.Int64  `tfsdk:"refresh_time"`
 	RetryTime         types.Int64  `tfsdk:"retry_time"`
@@ -61,6 +70,17 @@ type Model struct {
 	State             types.String `tfsdk:"state"`
 }
 
+// ResourceModel extends the Model shared with the data source by the resource-only attributes
+type ResourceModel struct {
+	Model
+	CloneZoneId   types.String `tfsdk:"clone_zone_id"`
+	AdjustRecords types.Bool   `tfsdk:"adjust_records"`
+	EnforceTTL    types.Bool   `tfsdk:"enforce_ttl"`
+}
+
+// recordSetsPageSize is the number of record sets requested per page when enforcing the TTL.
+const recordSetsPageSize = 100
+
 // NewZoneResource is a helper function to simplify the provider implementation.
 func NewZoneResource() resource.Resource {
 	return &zoneResource{}
@@ -68,7 +88,8 @@ func NewZoneResource() resource.Resource {
 
 // zoneResource is the resource implementation.
 type zoneResource struct {
-	client *dns.APIClient
+	client       *dns.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -93,12 +114,12 @@ func (r *zoneResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	var err error
 	if providerData.DnsCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "dns_custom_endpoint", providerData.DnsCustomEndpoint)
-		apiClient, err = dns.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.DnsCustomEndpoint),
 		)
 	} else {
-		apiClient, err = dns.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 		)
 	}
@@ -109,9 +130,16 @@ func (r *zoneResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "DNS zone client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *zoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	primaryOptions := []string{"primary", "secondary"}
@@ -126,6 +154,13 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the dns zone is associated.",
 				Required:    true,
@@ -181,6 +216,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(2000),
+					validate.CIDRList(),
 				},
 			},
 			"active": schema.BoolAttribute{
@@ -226,6 +262,14 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					int64validator.Between(60, 99999999),
 				},
 			},
+			"name_servers": schema.ListAttribute{
+				Description: "The authoritative name servers of the zone, i.e. the records of the `NS` record set at the apex of the zone, without the trailing dot. These are the name servers to be configured at the registrar of the domain.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"primaries": schema.ListAttribute{
 				Description: `Primary name server for secondary zone. E.g. ["1.2.3.4"]`,
 				Optional:    true,
@@ -281,7 +325,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"visibility": schema.StringAttribute{
-				Description: "Visibility of the zone. E.g. `public`.",
+				Description: "Visibility of the zone. E.g. `public`. It is determined by the API and can't be configured.",
 				Computed:    true,
 			},
 			"record_count": schema.Int64Attribute{
@@ -292,6 +336,32 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Zone state. E.g. `CREATE_SUCCEEDED`.",
 				Computed:    true,
 			},
+			"clone_zone_id": schema.StringAttribute{
+				Description: "ID of a zone in the same project to clone. The new zone is created with a copy of the record sets of this zone. Can't be used for secondary zones.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+					stringvalidator.ConflictsWith(path.MatchRoot("primaries")),
+				},
+			},
+			"adjust_records": schema.BoolAttribute{
+				Description: "If set to `true`, the DNS name of the cloned zone is replaced with the `dns_name` of the new zone in the content of the copied record sets. Can only be set together with `clone_zone_id`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("clone_zone_id")),
+				},
+			},
+			"enforce_ttl": schema.BoolAttribute{
+				Description: "If set to `true`, the TTL of all record sets in the zone (except `SOA`) is set to `default_ttl` whenever the zone is created or updated, e.g. to lower TTLs zone-wide before a migration. Record sets managed with an explicit `ttl` in `stackit_dns_record_set` will be reverted to their configured TTL by the next apply.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -299,7 +369,7 @@ func (r *zoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 // Create creates the resource and sets the initial Terraform state.
 func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -309,8 +379,13 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
+	if !model.CloneZoneId.IsNull() {
+		r.createByCloning(ctx, &model, resp)
+		return
+	}
+
 	// Generate API request body from model
-	payload, err := toCreatePayload(&model)
+	payload, err := toCreatePayload(&model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -318,36 +393,114 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Create new zone
 	createResp, err := r.client.CreateZone(ctx, projectId).CreateZonePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating zone", err, req.Plan)
 		return
 	}
 	zoneId := *createResp.Zone.Id
 
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
-	waitResp, err := wait.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId), "DNS zone creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Zone creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model)
+	err = mapFields(ctx, waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NameServers, err = readNameServers(ctx, r.client, model.ProjectId.ValueString(), model.ZoneId.ValueString(), model.DnsName.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Reading name servers: %v", err))
+		return
+	}
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.enforceTTL(ctx, &model, types.BoolNull(), &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Info(ctx, "DNS zone created")
 }
 
+// createByCloning creates the zone as a clone of the zone referenced by clone_zone_id and sets the initial Terraform state.
+// The clone inherits the settings of the original zone, so the configured settings are applied with an update afterwards.
+func (r *zoneResource) createByCloning(ctx context.Context, model *ResourceModel, resp *resource.CreateResponse) {
+	projectId := model.ProjectId.ValueString()
+	cloneZoneId := model.CloneZoneId.ValueString()
+	ctx = tflog.SetField(ctx, "clone_zone_id", cloneZoneId)
+
+	clonePayload, err := toClonePayload(model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	cloneResp, err := r.client.CloneZone(ctx, projectId, cloneZoneId).CloneZonePayload(*clonePayload).Execute()
+	if err != nil {
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating zone", err, nil)
+		return
+	}
+	if cloneResp == nil || cloneResp.Zone == nil || cloneResp.Zone.Id == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", "API didn't return zone ID")
+		return
+	}
+	zoneId := *cloneResp.Zone.Id
+
+	ctx = tflog.SetField(ctx, "zone_id", zoneId)
+	_, err = utils.Wait(ctx, wait.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId), "DNS zone clone")
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Zone creation waiting: %v", err))
+		return
+	}
+
+	updatePayload, err := toUpdatePayload(&model.Model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	_, err = r.client.PartialUpdateZone(ctx, projectId, zoneId).PartialUpdateZonePayload(*updatePayload).Execute()
+	if err != nil {
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating zone", err, nil)
+		return
+	}
+	waitResp, err := utils.Wait(ctx, wait.PartialUpdateZoneWaitHandler(ctx, r.client, projectId, zoneId), "DNS zone update")
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Zone update waiting: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, waitResp, &model.Model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	model.NameServers, err = readNameServers(ctx, r.client, model.ProjectId.ValueString(), model.ZoneId.ValueString(), model.DnsName.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Reading name servers: %v", err))
+		return
+	}
+	diags := resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.enforceTTL(ctx, model, types.BoolNull(), &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "DNS zone created by cloning")
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -369,11 +522,16 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, zoneResp, &model)
+	err = mapFields(ctx, zoneResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NameServers, err = readNameServers(ctx, r.client, model.ProjectId.ValueString(), model.ZoneId.ValueString(), model.DnsName.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zone", fmt.Sprintf("Reading name servers: %v", err))
+		return
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -386,7 +544,7 @@ func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -398,7 +556,7 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model)
+	payload, err := toUpdatePayload(&model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -406,32 +564,46 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update existing zone
 	_, err = r.client.PartialUpdateZone(ctx, projectId, zoneId).PartialUpdateZonePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating zone", err, req.Plan)
 		return
 	}
-	waitResp, err := wait.PartialUpdateZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.PartialUpdateZoneWaitHandler(ctx, r.client, projectId, zoneId), "DNS zone update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Zone update waiting: %v", err))
 		return
 	}
 
-	err = mapFields(ctx, waitResp, &model)
+	err = mapFields(ctx, waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	model.NameServers, err = readNameServers(ctx, r.client, model.ProjectId.ValueString(), model.ZoneId.ValueString(), model.DnsName.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Reading name servers: %v", err))
+		return
+	}
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	var priorEnforceTTL types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("enforce_ttl"), &priorEnforceTTL)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.enforceTTL(ctx, &model, priorEnforceTTL, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Info(ctx, "DNS zone updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -449,7 +621,7 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteZoneWaitHandler(ctx, r.client, projectId, zoneId), "DNS zone deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", fmt.Sprintf("Zone deletion waiting: %v", err))
 		return
@@ -481,6 +653,69 @@ func (r *zoneResource) ImportState(ctx context.Context, req resource.ImportState
 	tflog.Info(ctx, "DNS zone state imported")
 }
 
+// enforceTTL sets the TTL of all record sets of the zone to the default TTL of the zone, if enforce_ttl is set.
+// On failure, enforce_ttl is reset to its prior value in the state, so that the enforcement is retried by the next apply.
+func (r *zoneResource) enforceTTL(ctx context.Context, model *ResourceModel, priorEnforceTTL types.Bool, state *tfsdk.State, diags *diag.Diagnostics) {
+	if !model.EnforceTTL.ValueBool() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	zoneId := model.ZoneId.ValueString()
+	ttl := model.DefaultTTL.ValueInt64()
+
+	var recordSets []dns.RecordSet
+	for page := int32(1); ; page++ {
+		listResp, err := r.client.ListRecordSets(ctx, projectId, zoneId).StateNeq(wait.DeleteSuccess).Page(page).PageSize(recordSetsPageSize).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error enforcing record set TTL", fmt.Sprintf("Listing record sets: %v", err))
+			diags.Append(state.SetAttribute(ctx, path.Root("enforce_ttl"), priorEnforceTTL)...)
+			return
+		}
+		if listResp.RrSets != nil {
+			recordSets = append(recordSets, *listResp.RrSets...)
+		}
+		if listResp.TotalPages == nil || int64(page) >= *listResp.TotalPages {
+			break
+		}
+	}
+
+	for _, recordSetId := range recordSetsToEnforceTTL(recordSets, ttl) {
+		ctx := tflog.SetField(ctx, "record_set_id", recordSetId)
+		payload := dns.PartialUpdateRecordSetPayload{
+			Ttl: &ttl,
+		}
+		_, err := r.client.PartialUpdateRecordSet(ctx, projectId, zoneId, recordSetId).PartialUpdateRecordSetPayload(payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error enforcing record set TTL", fmt.Sprintf("Updating record set %q: %v", recordSetId, err))
+			diags.Append(state.SetAttribute(ctx, path.Root("enforce_ttl"), priorEnforceTTL)...)
+			return
+		}
+		_, err = utils.Wait(ctx, wait.PartialUpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId), "DNS record set update")
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error enforcing record set TTL", fmt.Sprintf("Record set %q update waiting: %v", recordSetId, err))
+			diags.Append(state.SetAttribute(ctx, path.Root("enforce_ttl"), priorEnforceTTL)...)
+			return
+		}
+	}
+	tflog.Info(ctx, "DNS record set TTLs enforced")
+}
+
+// recordSetsToEnforceTTL returns the IDs of the record sets whose TTL differs from the given TTL.
+// The SOA record set is managed through the zone itself and is skipped.
+func recordSetsToEnforceTTL(recordSets []dns.RecordSet, ttl int64) []string {
+	recordSetIds := []string{}
+	for _, recordSet := range recordSets {
+		if recordSet.Id == nil || (recordSet.Type != nil && *recordSet.Type == "SOA") {
+			continue
+		}
+		if recordSet.Ttl != nil && *recordSet.Ttl == ttl {
+			continue
+		}
+		recordSetIds = append(recordSetIds, *recordSet.Id)
+	}
+	return recordSetIds
+}
+
 func mapFields(ctx context.Context, zoneResp *dns.ZoneResponse, model *Model) error {
 	if zoneResp == nil || zoneResp.Zone == nil {
 		return fmt.Errorf("response input is nil")
@@ -514,6 +749,7 @@ func mapFields(ctx context.Context, zoneResp *dns.ZoneResponse, model *Model) er
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	if z.Primaries == nil {
 		model.Primaries = types.ListNull(types.StringType)
@@ -585,6 +821,19 @@ func toCreatePayload(model *Model) (*dns.CreateZonePayload, error) {
 	}, nil
 }
 
+func toClonePayload(model *ResourceModel) (*dns.CloneZonePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	return &dns.CloneZonePayload{
+		DnsName:       conversion.StringValueToPointer(model.DnsName),
+		Name:          conversion.StringValueToPointer(model.Name),
+		Description:   conversion.StringValueToPointer(model.Description),
+		AdjustRecords: conversion.BoolValueToPointer(model.AdjustRecords),
+	}, nil
+}
+
 func toUpdatePayload(model *Model) (*dns.PartialUpdateZonePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -603,3 +852,48 @@ func toUpdatePayload(model *Model) (*dns.PartialUpdateZonePayload, error) {
 		Primaries:     nil, // API returns error if this field is set, even if nothing changes
 	}, nil
 }
+
+// readNameServers returns the authoritative name servers of a zone, which are the records of the NS record set at the apex of the zone.
+func readNameServers(ctx context.Context, client *dns.APIClient, projectId, zoneId, dnsName string) (types.List, error) {
+	fqdn := strings.TrimSuffix(dnsName, ".") + "."
+	recordSetsResp, err := client.ListRecordSets(ctx, projectId, zoneId).NameEq(fqdn).TypeEq("NS").StateNeq(wait.DeleteSuccess).Execute()
+	if err != nil {
+		return types.ListNull(types.StringType), fmt.Errorf("listing record sets: %w", err)
+	}
+	return mapNameServers(ctx, recordSetsResp, dnsName)
+}
+
+// mapNameServers returns the sorted contents of the records of the NS record sets at the apex of the zone, without the trailing dot.
+func mapNameServers(ctx context.Context, recordSetsResp *dns.ListRecordSetsResponse, dnsName string) (types.List, error) {
+	if recordSetsResp == nil {
+		return types.ListNull(types.StringType), fmt.Errorf("response input is nil")
+	}
+
+	nameServers := []string{}
+	if recordSetsResp.RrSets != nil {
+		for _, recordSet := range *recordSetsResp.RrSets {
+			if recordSet.Type == nil || *recordSet.Type != "NS" || recordSet.Name == nil || recordSet.Records == nil {
+				continue
+			}
+			if !strings.EqualFold(strings.TrimSuffix(*recordSet.Name, "."), strings.TrimSuffix(dnsName, ".")) {
+				continue
+			}
+			for _, record := range *recordSet.Records {
+				if record.Content == nil {
+					continue
+				}
+				nameServer := strings.TrimSuffix(*record.Content, ".")
+				if !slices.Contains(nameServers, nameServer) {
+					nameServers = append(nameServers, nameServer)
+				}
+			}
+		}
+	}
+	sort.Strings(nameServers)
+
+	nameServersTF, diags := types.ListValueFrom(ctx, types.StringType, nameServers)
+	if diags.HasError() {
+		return types.ListNull(types.StringType), fmt.Errorf("mapping name servers: %w", core.DiagsToError(diags))
+	}
+	return nameServersTF, nil
+}
@@ -22,8 +22,9 @@ func TestMapFields(t *testing.T) {
 		{
 			"default_ok",
 			Model{
-				ProjectId: types.StringValue("pid"),
-				ZoneId:    types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				NameServers: types.ListNull(types.StringType),
 			},
 			&dns.ZoneResponse{
 				Zone: &dns.Zone{
@@ -32,8 +33,10 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("pid,zid"),
+				ImportId:          types.StringValue("pid,zid"),
 				ProjectId:         types.StringValue("pid"),
 				ZoneId:            types.StringValue("zid"),
+				NameServers:       types.ListNull(types.StringType),
 				Name:              types.StringNull(),
 				DnsName:           types.StringNull(),
 				Acl:               types.StringNull(),
@@ -54,8 +57,9 @@ func TestMapFields(t *testing.T) {
 		{
 			"values_ok",
 			Model{
-				ProjectId: types.StringValue("pid"),
-				ZoneId:    types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				NameServers: types.ListNull(types.StringType),
 			},
 			&dns.ZoneResponse{
 				Zone: &dns.Zone{
@@ -88,8 +92,10 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("pid,zid"),
+				ImportId:          types.StringValue("pid,zid"),
 				ProjectId:         types.StringValue("pid"),
 				ZoneId:            types.StringValue("zid"),
+				NameServers:       types.ListNull(types.StringType),
 				Name:              types.StringValue("name"),
 				DnsName:           types.StringValue("dnsname"),
 				Acl:               types.StringValue("acl"),
@@ -117,8 +123,9 @@ func TestMapFields(t *testing.T) {
 		{
 			"primaries_unordered",
 			Model{
-				ProjectId: types.StringValue("pid"),
-				ZoneId:    types.StringValue("zid"),
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				NameServers: types.ListNull(types.StringType),
 				Primaries: types.ListValueMust(types.StringType, []attr.Value{
 					types.StringValue("primary2"),
 					types.StringValue("primary1"),
@@ -158,8 +165,10 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("pid,zid"),
+				ImportId:          types.StringValue("pid,zid"),
 				ProjectId:         types.StringValue("pid"),
 				ZoneId:            types.StringValue("zid"),
+				NameServers:       types.ListNull(types.StringType),
 				Name:              types.StringValue("name"),
 				DnsName:           types.StringValue("dnsname"),
 				Acl:               types.StringValue("acl"),
@@ -188,9 +197,11 @@ func TestMapFields(t *testing.T) {
 		{
 			"nullable_fields_and_int_conversions_ok",
 			Model{
-				Id:        types.StringValue("pid,zid"),
-				ProjectId: types.StringValue("pid"),
-				ZoneId:    types.StringValue("zid"),
+				Id:          types.StringValue("pid,zid"),
+				ImportId:    types.StringValue("pid,zid"),
+				ProjectId:   types.StringValue("pid"),
+				ZoneId:      types.StringValue("zid"),
+				NameServers: types.ListNull(types.StringType),
 			},
 			&dns.ZoneResponse{
 				Zone: &dns.Zone{
@@ -222,8 +233,10 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("pid,zid"),
+				ImportId:          types.StringValue("pid,zid"),
 				ProjectId:         types.StringValue("pid"),
 				ZoneId:            types.StringValue("zid"),
+				NameServers:       types.ListNull(types.StringType),
 				Name:              types.StringValue("name"),
 				DnsName:           types.StringValue("dnsname"),
 				Acl:               types.StringValue("acl"),
@@ -435,3 +448,205 @@ func TestToPayloadUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestToClonePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ResourceModel
+		expected    *dns.CloneZonePayload
+		isValid     bool
+	}{
+		{
+			"default_values_ok",
+			&ResourceModel{
+				Model: Model{
+					DnsName: types.StringValue("DnsName"),
+				},
+				CloneZoneId: types.StringValue("cid"),
+			},
+			&dns.CloneZonePayload{
+				DnsName: utils.Ptr("DnsName"),
+			},
+			true,
+		},
+		{
+			"simple_values_ok",
+			&ResourceModel{
+				Model: Model{
+					Name:        types.StringValue("Name"),
+					DnsName:     types.StringValue("DnsName"),
+					Description: types.StringValue("Description"),
+					Acl:         types.StringValue("Acl"),
+				},
+				CloneZoneId:   types.StringValue("cid"),
+				AdjustRecords: types.BoolValue(true),
+			},
+			&dns.CloneZonePayload{
+				Name:          utils.Ptr("Name"),
+				DnsName:       utils.Ptr("DnsName"),
+				Description:   utils.Ptr("Description"),
+				AdjustRecords: utils.Ptr(true),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toClonePayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordSetsToEnforceTTL(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []dns.RecordSet
+		ttl         int64
+		expected    []string
+	}{
+		{
+			"no_record_sets",
+			nil,
+			3600,
+			[]string{},
+		},
+		{
+			"mixed_ttls",
+			[]dns.RecordSet{
+				{Id: utils.Ptr("rid-1"), Type: utils.Ptr("A"), Ttl: utils.Ptr(int64(3600))},
+				{Id: utils.Ptr("rid-2"), Type: utils.Ptr("A"), Ttl: utils.Ptr(int64(60))},
+				{Id: utils.Ptr("rid-3"), Type: utils.Ptr("CNAME")},
+			},
+			3600,
+			[]string{"rid-2", "rid-3"},
+		},
+		{
+			"soa_and_missing_id_skipped",
+			[]dns.RecordSet{
+				{Id: utils.Ptr("rid-soa"), Type: utils.Ptr("SOA"), Ttl: utils.Ptr(int64(60))},
+				{Type: utils.Ptr("A"), Ttl: utils.Ptr(int64(60))},
+				{Id: utils.Ptr("rid-ns"), Type: utils.Ptr("NS"), Ttl: utils.Ptr(int64(60))},
+			},
+			3600,
+			[]string{"rid-ns"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := recordSetsToEnforceTTL(tt.input, tt.ttl)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapNameServers(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *dns.ListRecordSetsResponse
+		dnsName     string
+		expected    types.List
+		isValid     bool
+	}{
+		{
+			"no_record_sets",
+			&dns.ListRecordSetsResponse{},
+			"example.com",
+			types.ListValueMust(types.StringType, []attr.Value{}),
+			true,
+		},
+		{
+			"apex_ns_record_set",
+			&dns.ListRecordSetsResponse{
+				RrSets: &[]dns.RecordSet{
+					{
+						Name: utils.Ptr("example.com."),
+						Type: utils.Ptr("NS"),
+						Records: &[]dns.Record{
+							{Content: utils.Ptr("ns2.example.cloud.")},
+							{Content: utils.Ptr("ns1.example.cloud.")},
+							{Content: nil},
+						},
+					},
+				},
+			},
+			"example.com",
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("ns1.example.cloud"),
+				types.StringValue("ns2.example.cloud"),
+			}),
+			true,
+		},
+		{
+			"delegation_and_other_types_skipped",
+			&dns.ListRecordSetsResponse{
+				RrSets: &[]dns.RecordSet{
+					{
+						Name:    utils.Ptr("sub.example.com."),
+						Type:    utils.Ptr("NS"),
+						Records: &[]dns.Record{{Content: utils.Ptr("ns.sub.example.com.")}},
+					},
+					{
+						Name:    utils.Ptr("example.com."),
+						Type:    utils.Ptr("A"),
+						Records: &[]dns.Record{{Content: utils.Ptr("1.2.3.4")}},
+					},
+					{
+						Name:    utils.Ptr("example.com."),
+						Type:    utils.Ptr("NS"),
+						Records: &[]dns.Record{{Content: utils.Ptr("ns1.example.cloud.")}},
+					},
+				},
+			},
+			"example.com.",
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("ns1.example.cloud"),
+			}),
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			"example.com",
+			types.ListNull(types.StringType),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapNameServers(context.Background(), tt.input, tt.dnsName)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
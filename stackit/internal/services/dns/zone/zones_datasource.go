@@ -0,0 +1,237 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// zonesPageSize is the number of zones requested per page when listing zones.
+const zonesPageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &zonesDataSource{}
+)
+
+// NewZonesDataSource is a helper function to simplify the provider implementation.
+func NewZonesDataSource() datasource.DataSource {
+	return &zonesDataSource{}
+}
+
+// zonesDataSource is the data source implementation.
+type zonesDataSource struct {
+	client *dns.APIClient
+}
+
+// zonesDataSourceModel maps the data source schema data.
+type zonesDataSourceModel struct {
+	Id          types.String               `tfsdk:"id"` // needed by TF
+	ProjectId   types.String               `tfsdk:"project_id"`
+	NameLike    types.String               `tfsdk:"name_like"`
+	DnsNameLike types.String               `tfsdk:"dns_name_like"`
+	Active      types.Bool                 `tfsdk:"active"`
+	Items       []zonesDataSourceItemModel `tfsdk:"items"`
+}
+
+// zonesDataSourceItemModel maps zone schema data.
+type zonesDataSourceItemModel struct {
+	ZoneId      types.String `tfsdk:"zone_id"`
+	Name        types.String `tfsdk:"name"`
+	DnsName     types.String `tfsdk:"dns_name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	State       types.String `tfsdk:"state"`
+	Active      types.Bool   `tfsdk:"active"`
+}
+
+// Metadata returns the data source type name.
+func (d *zonesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zones"
+}
+
+func (d *zonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var apiClient *dns.APIClient
+	var err error
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	if providerData.DnsCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.DnsCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, dns.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+		)
+	}
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	d.client = apiClient
+	tflog.Info(ctx, "DNS zones client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *zonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS zones data source schema. Lists all DNS zones of a project, optionally filtered by the API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the dns zones are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name_like": schema.StringAttribute{
+				Description: "Only return zones whose user given name contains this value.",
+				Optional:    true,
+			},
+			"dns_name_like": schema.StringAttribute{
+				Description: "Only return zones whose zone name contains this value.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only return zones which are active (`true`) or inactive (`false`).",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The DNS zones matching the given filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"zone_id": schema.StringAttribute{
+							Description: "The zone ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The user given name of the zone.",
+							Computed:    true,
+						},
+						"dns_name": schema.StringAttribute{
+							Description: "The zone name. E.g. `example.com`",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the zone.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Zone type.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Zone state.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Specifies if the zone is active or not.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *zonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model zonesDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	var zones []dns.Zone
+	for page := int32(1); ; page++ {
+		request := d.client.ListZones(ctx, projectId).Page(page).PageSize(zonesPageSize)
+		if !model.NameLike.IsNull() {
+			request = request.NameLike(model.NameLike.ValueString())
+		}
+		if !model.DnsNameLike.IsNull() {
+			request = request.DnsNameLike(model.DnsNameLike.ValueString())
+		}
+		if !model.Active.IsNull() {
+			request = request.ActiveEq(model.Active.ValueBool())
+		}
+		zonesResp, err := request.Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zones", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
+		if zonesResp.Zones != nil {
+			zones = append(zones, *zonesResp.Zones...)
+		}
+		if zonesResp.TotalPages == nil || int64(page) >= *zonesResp.TotalPages {
+			break
+		}
+	}
+
+	err := mapZonesDataSourceFields(zones, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading zones", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "DNS zones read")
+}
+
+func mapZonesDataSourceFields(zones []dns.Zone, model *zonesDataSourceModel) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	model.Items = []zonesDataSourceItemModel{}
+	for i := range zones {
+		zone := &zones[i]
+		if zone.Id == nil {
+			return fmt.Errorf("zone id not present")
+		}
+		model.Items = append(model.Items, zonesDataSourceItemModel{
+			ZoneId:      types.StringPointerValue(zone.Id),
+			Name:        types.StringPointerValue(zone.Name),
+			DnsName:     types.StringPointerValue(zone.DnsName),
+			Description: types.StringPointerValue(zone.Description),
+			Type:        types.StringPointerValue(zone.Type),
+			State:       types.StringPointerValue(zone.State),
+			Active:      types.BoolPointerValue(zone.Active),
+		})
+	}
+	return nil
+}
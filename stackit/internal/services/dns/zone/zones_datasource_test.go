@@ -0,0 +1,107 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/dns"
+)
+
+func TestMapZonesDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []dns.Zone
+		expected    zonesDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_zones",
+			nil,
+			zonesDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items:     []zonesDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			[]dns.Zone{
+				{
+					Id:      utils.Ptr("zid-1"),
+					Name:    utils.Ptr("name-1"),
+					DnsName: utils.Ptr("one.example.com"),
+					Type:    utils.Ptr("primary"),
+					State:   utils.Ptr("CREATE_SUCCEEDED"),
+					Active:  utils.Ptr(true),
+				},
+				{
+					Id:          utils.Ptr("zid-2"),
+					Name:        utils.Ptr("name-2"),
+					DnsName:     utils.Ptr("two.example.com"),
+					Description: utils.Ptr("description"),
+					Type:        utils.Ptr("secondary"),
+					State:       utils.Ptr("UPDATE_SUCCEEDED"),
+					Active:      utils.Ptr(false),
+				},
+			},
+			zonesDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items: []zonesDataSourceItemModel{
+					{
+						ZoneId:      types.StringValue("zid-1"),
+						Name:        types.StringValue("name-1"),
+						DnsName:     types.StringValue("one.example.com"),
+						Description: types.StringNull(),
+						Type:        types.StringValue("primary"),
+						State:       types.StringValue("CREATE_SUCCEEDED"),
+						Active:      types.BoolValue(true),
+					},
+					{
+						ZoneId:      types.StringValue("zid-2"),
+						Name:        types.StringValue("name-2"),
+						DnsName:     types.StringValue("two.example.com"),
+						Description: types.StringValue("description"),
+						Type:        types.StringValue("secondary"),
+						State:       types.StringValue("UPDATE_SUCCEEDED"),
+						Active:      types.BoolValue(false),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_zone_id",
+			[]dns.Zone{
+				{
+					Name: utils.Ptr("name"),
+				},
+			},
+			zonesDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &zonesDataSourceModel{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapZonesDataSourceFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -61,12 +61,12 @@ func (d *affinityGroupDatasource) Configure(ctx context.Context, req datasource.
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -95,6 +95,10 @@ func (d *affinityGroupDatasource) Schema(_ context.Context, _ datasource.SchemaR
 				Description: "Terraform's internal resource identifier. It is structured as \"`project_id`,`affinity_group_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT Project ID to which the affinity group is associated.",
 				Required:    true,
@@ -41,6 +41,7 @@ var (
 // Model is the provider's internal model
 type Model struct {
 	Id              types.String `tfsdk:"id"`
+	ImportId        types.String `tfsdk:"import_id"`
 	ProjectId       types.String `tfsdk:"project_id"`
 	AffinityGroupId types.String `tfsdk:"affinity_group_id"`
 	Name            types.String `tfsdk:"name"`
@@ -87,12 +88,12 @@ func (r *affinityGroupResource) Configure(ctx context.Context, req resource.Conf
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -120,6 +121,13 @@ func (r *affinityGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT Project ID to which the affinity group is associated.",
 				Required:    true,
@@ -197,7 +205,7 @@ func (r *affinityGroupResource) Create(ctx context.Context, req resource.CreateR
 	}
 	affinityGroupResp, err := r.client.CreateAffinityGroup(ctx, projectId).CreateAffinityGroupPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating affinity group", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating affinity group", err, req.Plan)
 		return
 	}
 	ctx = tflog.SetField(ctx, "affinity_group_id", affinityGroupResp.Id)
@@ -343,6 +351,7 @@ func mapFields(ctx context.Context, affinityGroupResp *iaas.AffinityGroup, model
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	if affinityGroupResp.Members != nil && len(*affinityGroupResp.Members) > 0 {
 		members, diags := types.ListValueFrom(ctx, types.StringType, *affinityGroupResp.Members)
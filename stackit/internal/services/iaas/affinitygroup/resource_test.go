@@ -29,6 +29,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:              types.StringValue("pid,aid"),
+				ImportId:        types.StringValue("pid,aid"),
 				ProjectId:       types.StringValue("pid"),
 				AffinityGroupId: types.StringValue("aid"),
 				Name:            types.StringNull(),
@@ -19,6 +19,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -45,6 +46,7 @@ type DataSourceModel struct {
 	Config      types.Object `tfsdk:"config"`
 	Checksum    types.Object `tfsdk:"checksum"`
 	Labels      types.Map    `tfsdk:"labels"`
+	Status      types.String `tfsdk:"status"`
 }
 
 // NewImageDataSource is a helper function to simplify the provider implementation.
@@ -86,12 +88,12 @@ func (d *imageDataSource) Configure(ctx context.Context, req datasource.Configur
 	}
 
 	if providerData.IaaSCustomEndpoint != "" {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -227,6 +229,10 @@ func (r *imageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the image, e.g. `AVAILABLE`.",
+				Computed:    true,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
@@ -359,18 +365,9 @@ func mapDataSourceFields(ctx context.Context, imageResp *iaas.Image, model *Data
 	}
 
 	// Map labels
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if imageResp.Labels != nil && len(*imageResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *imageResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, imageResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	model.ImageId = types.StringValue(imageId)
@@ -381,6 +378,7 @@ func mapDataSourceFields(ctx context.Context, imageResp *iaas.Image, model *Data
 	model.Protected = types.BoolPointerValue(imageResp.Protected)
 	model.Scope = types.StringPointerValue(imageResp.Scope)
 	model.Labels = labels
+	model.Status = types.StringPointerValue(imageResp.Status)
 	model.Config = configObject
 	model.Checksum = checksumObject
 	return nil
@@ -29,6 +29,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -42,10 +43,12 @@ var (
 	_ resource.Resource                = &imageResource{}
 	_ resource.ResourceWithConfigure   = &imageResource{}
 	_ resource.ResourceWithImportState = &imageResource{}
+	_ resource.ResourceWithModifyPlan  = &imageResource{}
 )
 
 type Model struct {
 	Id            types.String `tfsdk:"id"` // needed by TF
+	ImportId      types.String `tfsdk:"import_id"`
 	ProjectId     types.String `tfsdk:"project_id"`
 	ImageId       types.String `tfsdk:"image_id"`
 	Name          types.String `tfsdk:"name"`
@@ -58,6 +61,7 @@ type Model struct {
 	Checksum      types.Object `tfsdk:"checksum"`
 	Labels        types.Map    `tfsdk:"labels"`
 	LocalFilePath types.String `tfsdk:"local_file_path"`
+	Status        types.String `tfsdk:"status"`
 }
 
 // Struct corresponding to Model.Config
@@ -113,7 +117,10 @@ func NewImageResource() resource.Resource {
 
 // imageResource is the resource implementation.
 type imageResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	transport     http.RoundTripper
+	defaultLabels map[string]string
+	ignoredLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -146,12 +153,12 @@ func (r *imageResource) Configure(ctx context.Context, req resource.ConfigureReq
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -163,9 +170,18 @@ func (r *imageResource) Configure(ctx context.Context, req resource.ConfigureReq
 	}
 
 	r.client = apiClient
+	r.transport = providerData.Transport
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "iaas client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to add the default labels of the provider to the planned labels.
+func (r *imageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -178,6 +194,13 @@ func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the image is associated.",
 				Required:    true,
@@ -390,10 +413,16 @@ func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the image, e.g. `AVAILABLE`.",
+				Computed:    true,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 		},
 	}
@@ -422,7 +451,7 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Create new image
 	imageCreateResp, err := r.client.CreateImage(ctx, projectId).CreateImagePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating image", err, req.Plan)
 		return
 	}
 	ctx = tflog.SetField(ctx, "image_id", *imageCreateResp.Id)
@@ -430,11 +459,12 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Get the image object, as the create response does not contain all fields
 	image, err := r.client.GetImage(ctx, projectId, *imageCreateResp.Id).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating image", err, req.Plan)
 		return
 	}
 
 	// Map response body to schema
+	image.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, image.Labels, model.Labels)
 	err = mapFields(ctx, image, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Processing API payload: %v", err))
@@ -449,20 +479,21 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Upload image
-	err = uploadImage(ctx, &resp.Diagnostics, model.LocalFilePath.ValueString(), *imageCreateResp.UploadUrl)
+	err = uploadImage(ctx, &resp.Diagnostics, r.transport, model.LocalFilePath.ValueString(), *imageCreateResp.UploadUrl)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Uploading image: %v", err))
 		return
 	}
 
 	// Wait for image to become available
-	waitResp, err := wait.UploadImageWaitHandler(ctx, r.client, projectId, *imageCreateResp.Id).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.UploadImageWaitHandler(ctx, r.client, projectId, *imageCreateResp.Id), "image upload")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Waiting for image to become available: %v", err))
 		return
 	}
 
 	// Map response body to schema
+	waitResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, waitResp.Labels, model.Labels)
 	err = mapFields(ctx, waitResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Processing API payload: %v", err))
@@ -503,6 +534,7 @@ func (r *imageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Map response body to schema
+	imageResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, imageResp.Labels, model.Labels)
 	err = mapFields(ctx, imageResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image", fmt.Sprintf("Processing API payload: %v", err))
@@ -548,10 +580,11 @@ func (r *imageResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update existing image
 	updatedImage, err := r.client.UpdateImage(ctx, projectId, imageId).UpdateImagePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating image", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating image", err, req.Plan)
 		return
 	}
 
+	updatedImage.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, updatedImage.Labels, model.Labels)
 	err = mapFields(ctx, updatedImage, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating image", fmt.Sprintf("Processing API payload: %v", err))
@@ -586,7 +619,7 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteImageWaitHandler(ctx, r.client, projectId, imageId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteImageWaitHandler(ctx, r.client, projectId, imageId), "image deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image", fmt.Sprintf("image deletion waiting: %v", err))
 		return
@@ -642,6 +675,7 @@ func mapFields(ctx context.Context, imageResp *iaas.Image, model *Model) error {
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	// Map config
 	var configModel = &configModel{}
@@ -702,18 +736,9 @@ func mapFields(ctx context.Context, imageResp *iaas.Image, model *Model) error {
 	}
 
 	// Map labels
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if imageResp.Labels != nil && len(*imageResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *imageResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, imageResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	model.ImageId = types.StringValue(imageId)
@@ -724,6 +749,7 @@ func mapFields(ctx context.Context, imageResp *iaas.Image, model *Model) error {
 	model.Protected = types.BoolPointerValue(imageResp.Protected)
 	model.Scope = types.StringPointerValue(imageResp.Scope)
 	model.Labels = labels
+	model.Status = types.StringPointerValue(imageResp.Status)
 	model.Config = configObject
 	model.Checksum = checksumObject
 	return nil
@@ -820,7 +846,7 @@ func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map)
 	}, nil
 }
 
-func uploadImage(ctx context.Context, diags *diag.Diagnostics, filePath, uploadURL string) error {
+func uploadImage(ctx context.Context, diags *diag.Diagnostics, transport http.RoundTripper, filePath, uploadURL string) error {
 	if filePath == "" {
 		return fmt.Errorf("file path is empty")
 	}
@@ -844,7 +870,7 @@ func uploadImage(ctx context.Context, diags *diag.Diagnostics, filePath, uploadU
 	req.Header.Set("Content-Type", "application/octet-stream")
 	req.ContentLength = stat.Size()
 
-	client := &http.Client{}
+	client := &http.Client{Transport: transport}
 	resp, err := client.Do(req)
 	if err != nil {
 		return fmt.Errorf("upload image: %w", err)
@@ -35,6 +35,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:        types.StringValue("pid,iid"),
+				ImportId:  types.StringValue("pid,iid"),
 				ProjectId: types.StringValue("pid"),
 				ImageId:   types.StringValue("iid"),
 				Labels:    types.MapNull(types.StringType),
@@ -80,6 +81,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("pid,iid"),
+				ImportId:    types.StringValue("pid,iid"),
 				ProjectId:   types.StringValue("pid"),
 				ImageId:     types.StringValue("iid"),
 				Name:        types.StringValue("name"),
@@ -125,6 +127,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:        types.StringValue("pid,iid"),
+				ImportId:  types.StringValue("pid,iid"),
 				ProjectId: types.StringValue("pid"),
 				ImageId:   types.StringValue("iid"),
 				Labels:    types.MapValueMust(types.StringType, map[string]attr.Value{}),
@@ -178,6 +181,7 @@ func TestToCreatePayload(t *testing.T) {
 			"ok",
 			&Model{
 				Id:          types.StringValue("pid,iid"),
+				ImportId:    types.StringValue("pid,iid"),
 				ProjectId:   types.StringValue("pid"),
 				ImageId:     types.StringValue("iid"),
 				Name:        types.StringValue("name"),
@@ -262,6 +266,7 @@ func TestToUpdatePayload(t *testing.T) {
 			"default_ok",
 			&Model{
 				Id:          types.StringValue("pid,iid"),
+				ImportId:    types.StringValue("pid,iid"),
 				ProjectId:   types.StringValue("pid"),
 				ImageId:     types.StringValue("iid"),
 				Name:        types.StringValue("name"),
@@ -382,7 +387,7 @@ func Test_UploadImage(t *testing.T) {
 			}
 
 			// Call the function
-			err = uploadImage(context.Background(), &diag.Diagnostics{}, tt.filePath, uploadURL.String())
+			err = uploadImage(context.Background(), &diag.Diagnostics{}, http.DefaultTransport, tt.filePath, uploadURL.String())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("uploadImage() error = %v, wantErr %v", err, tt.wantErr)
 			}
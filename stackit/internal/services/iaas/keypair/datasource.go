@@ -65,12 +65,12 @@ func (d *keyPairDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 
 	if providerData.IaaSCustomEndpoint != "" {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -96,6 +96,10 @@ func (r *keyPairDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "Terraform's internal resource ID. It takes the value of the key pair \"`name`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"name": schema.StringAttribute{
 				Description: "The name of the SSH key pair.",
 				Required:    true,
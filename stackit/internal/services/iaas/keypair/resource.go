@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +19,8 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
 // resourceBetaCheckDone is used to prevent multiple checks for beta resources.
@@ -36,6 +37,7 @@ var (
 
 type Model struct {
 	Id          types.String `tfsdk:"id"` // needed by TF
+	ImportId    types.String `tfsdk:"import_id"`
 	Name        types.String `tfsdk:"name"`
 	PublicKey   types.String `tfsdk:"public_key"`
 	Fingerprint types.String `tfsdk:"fingerprint"`
@@ -49,7 +51,9 @@ func NewKeyPairResource() resource.Resource {
 
 // keyPairResource is the resource implementation.
 type keyPairResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -82,12 +86,12 @@ func (r *keyPairResource) Configure(ctx context.Context, req resource.ConfigureR
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -99,6 +103,8 @@ func (r *keyPairResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 
 	r.client = apiClient
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "iaas client configured")
 }
 
@@ -117,6 +123,13 @@ func (r *keyPairResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Description: "The name of the SSH key pair.",
 				Required:    true,
@@ -142,6 +155,8 @@ func (r *keyPairResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Labels are key-value string pairs which can be attached to a resource container.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 		},
 	}
@@ -150,6 +165,7 @@ func (r *keyPairResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 // ModifyPlan will be called in the Plan phase.
 // It will check if the plan contains a change that requires replacement. If yes, it will show a warning to the user.
 func (r *keyPairResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
 	// If the state is empty we are creating a new resource
 	// If the plan is empty we are deleting the resource
 	// In both cases we don't need to check for replacement
@@ -194,11 +210,12 @@ func (r *keyPairResource) Create(ctx context.Context, req resource.CreateRequest
 
 	keyPair, err := r.client.CreateKeyPair(ctx).CreateKeyPairPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key pair", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating key pair", err, req.Plan)
 		return
 	}
 
 	// Map response body to schema
+	keyPair.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, keyPair.Labels, model.Labels)
 	err = mapFields(ctx, keyPair, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating key pair", fmt.Sprintf("Processing API payload: %v", err))
@@ -236,6 +253,7 @@ func (r *keyPairResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Map response body to schema
+	keyPairResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, keyPairResp.Labels, model.Labels)
 	err = mapFields(ctx, keyPairResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading key pair", fmt.Sprintf("Processing API payload: %v", err))
@@ -279,10 +297,11 @@ func (r *keyPairResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update existing key pair
 	updatedKeyPair, err := r.client.UpdateKeyPair(ctx, name).UpdateKeyPairPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key pair", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating key pair", err, req.Plan)
 		return
 	}
 
+	updatedKeyPair.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, updatedKeyPair.Labels, model.Labels)
 	err = mapFields(ctx, updatedKeyPair, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating key pair", fmt.Sprintf("Processing API payload: %v", err))
@@ -357,21 +376,13 @@ func mapFields(ctx context.Context, keyPairResp *iaas.Keypair, model *Model) err
 	}
 
 	model.Id = types.StringValue(name)
+	model.ImportId = model.Id
 	model.PublicKey = types.StringPointerValue(keyPairResp.PublicKey)
 	model.Fingerprint = types.StringPointerValue(keyPairResp.Fingerprint)
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if keyPairResp.Labels != nil && len(*keyPairResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *keyPairResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, keyPairResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 	model.Labels = labels
 
@@ -29,6 +29,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("name"),
+				ImportId:    types.StringValue("name"),
 				Name:        types.StringValue("name"),
 				PublicKey:   types.StringNull(),
 				Fingerprint: types.StringNull(),
@@ -51,6 +52,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("name"),
+				ImportId:    types.StringValue("name"),
 				Name:        types.StringValue("name"),
 				PublicKey:   types.StringValue("public_key"),
 				Fingerprint: types.StringValue("fingerprint"),
@@ -73,6 +75,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:          types.StringValue("name"),
+				ImportId:    types.StringValue("name"),
 				Name:        types.StringValue("name"),
 				PublicKey:   types.StringValue("public_key"),
 				Fingerprint: types.StringValue("fingerprint"),
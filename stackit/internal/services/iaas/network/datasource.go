@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -46,6 +45,7 @@ type DataSourceModel struct {
 	PublicIP         types.String `tfsdk:"public_ip"`
 	Labels           types.Map    `tfsdk:"labels"`
 	Routed           types.Bool   `tfsdk:"routed"`
+	State            types.String `tfsdk:"state"`
 }
 
 // NewNetworkDataSource is a helper function to simplify the provider implementation.
@@ -79,12 +79,12 @@ func (d *networkDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 
 	if providerData.IaaSCustomEndpoint != "" {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -191,6 +191,10 @@ func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "The public IP of the network.",
 				Computed:    true,
 			},
+			"state": schema.StringAttribute{
+				Description: "The state of the network, e.g. `CREATED`.",
+				Computed:    true,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
@@ -266,18 +270,9 @@ func mapDataSourceFields(ctx context.Context, networkResp *iaas.Network, model *
 		strings.Join(idParts, core.Separator),
 	)
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if networkResp.Labels != nil && len(*networkResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *networkResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, networkResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	// IPv4
@@ -375,6 +370,7 @@ func mapDataSourceFields(ctx context.Context, networkResp *iaas.Network, model *
 	model.Name = types.StringPointerValue(networkResp.Name)
 	model.PublicIP = types.StringPointerValue(networkResp.PublicIp)
 	model.Labels = labels
+	model.State = types.StringPointerValue(networkResp.State)
 	model.Routed = types.BoolPointerValue(networkResp.Routed)
 
 	return nil
@@ -0,0 +1,234 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &networksDataSource{}
+)
+
+// networksDataSourceModel maps the data source schema data.
+type networksDataSourceModel struct {
+	Id            types.String                  `tfsdk:"id"` // needed by TF
+	ProjectId     types.String                  `tfsdk:"project_id"`
+	LabelSelector types.String                  `tfsdk:"label_selector"`
+	Items         []networksDataSourceItemModel `tfsdk:"items"`
+}
+
+// networksDataSourceItemModel maps network schema data.
+type networksDataSourceItemModel struct {
+	NetworkId    types.String `tfsdk:"network_id"`
+	Name         types.String `tfsdk:"name"`
+	IPv4Prefixes types.List   `tfsdk:"ipv4_prefixes"`
+	IPv6Prefixes types.List   `tfsdk:"ipv6_prefixes"`
+	PublicIP     types.String `tfsdk:"public_ip"`
+	Labels       types.Map    `tfsdk:"labels"`
+	Routed       types.Bool   `tfsdk:"routed"`
+}
+
+// NewNetworksDataSource is a helper function to simplify the provider implementation.
+func NewNetworksDataSource() datasource.DataSource {
+	return &networksDataSource{}
+}
+
+// networksDataSource is the data source implementation.
+type networksDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *networksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+func (d *networksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var apiClient *iaas.APIClient
+	var err error
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	if providerData.IaaSCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.IaaSCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithRegion(providerData.Region),
+		)
+	}
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	d.client = apiClient
+	tflog.Info(ctx, "IaaS client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *networksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Networks data source schema. Lists all networks of a project. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the networks are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"label_selector": schema.StringAttribute{
+				Description: "Filter the networks by labels, e.g. `env=prod`. The filtering is done by the API.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The networks matching the given filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network_id": schema.StringAttribute{
+							Description: "The network ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the network.",
+							Computed:    true,
+						},
+						"ipv4_prefixes": schema.ListAttribute{
+							Description: "The IPv4 prefixes of the network.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"ipv6_prefixes": schema.ListAttribute{
+							Description: "The IPv6 prefixes of the network.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"public_ip": schema.StringAttribute{
+							Description: "The public IP of the network.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"routed": schema.BoolAttribute{
+							Description: "Shows if the network is routed and therefore accessible from other networks.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model networksDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	request := d.client.ListNetworks(ctx, projectId)
+	if !model.LabelSelector.IsNull() {
+		request = request.LabelSelector(model.LabelSelector.ValueString())
+	}
+	networksResp, err := request.Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading networks", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapNetworksDataSourceFields(ctx, networksResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading networks", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Networks read")
+}
+
+func mapNetworksDataSourceFields(ctx context.Context, networksResp *iaas.NetworkListResponse, model *networksDataSourceModel) error {
+	if networksResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	model.Items = []networksDataSourceItemModel{}
+	if networksResp.Items == nil {
+		return nil
+	}
+	for i := range *networksResp.Items {
+		network := &(*networksResp.Items)[i]
+		if network.NetworkId == nil {
+			return fmt.Errorf("network id not present")
+		}
+
+		ipv4Prefixes, diags := types.ListValueFrom(ctx, types.StringType, network.Prefixes)
+		if diags.HasError() {
+			return fmt.Errorf("mapping ipv4 prefixes of network %q: %w", *network.NetworkId, core.DiagsToError(diags))
+		}
+		ipv6Prefixes, diags := types.ListValueFrom(ctx, types.StringType, network.PrefixesV6)
+		if diags.HasError() {
+			return fmt.Errorf("mapping ipv6 prefixes of network %q: %w", *network.NetworkId, core.DiagsToError(diags))
+		}
+		labels, err := utils.MapLabels(ctx, network.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of network %q: %w", *network.NetworkId, err)
+		}
+
+		model.Items = append(model.Items, networksDataSourceItemModel{
+			NetworkId:    types.StringPointerValue(network.NetworkId),
+			Name:         types.StringPointerValue(network.Name),
+			IPv4Prefixes: ipv4Prefixes,
+			IPv6Prefixes: ipv6Prefixes,
+			PublicIP:     types.StringPointerValue(network.PublicIp),
+			Labels:       labels,
+			Routed:       types.BoolPointerValue(network.Routed),
+		})
+	}
+	return nil
+}
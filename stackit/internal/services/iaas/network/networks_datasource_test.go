@@ -0,0 +1,120 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapNetworksDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.NetworkListResponse
+		expected    networksDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_networks",
+			&iaas.NetworkListResponse{},
+			networksDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items:     []networksDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{
+						NetworkId:  utils.Ptr("nid"),
+						Name:       utils.Ptr("name"),
+						Prefixes:   &[]string{"192.168.42.0/24"},
+						PrefixesV6: &[]string{},
+						PublicIp:   utils.Ptr("1.2.3.4"),
+						Labels: &map[string]interface{}{
+							"key": "value",
+						},
+						Routed: utils.Ptr(true),
+					},
+					{
+						NetworkId: utils.Ptr("nid-2"),
+					},
+				},
+			},
+			networksDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items: []networksDataSourceItemModel{
+					{
+						NetworkId: types.StringValue("nid"),
+						Name:      types.StringValue("name"),
+						IPv4Prefixes: types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("192.168.42.0/24"),
+						}),
+						IPv6Prefixes: types.ListValueMust(types.StringType, []attr.Value{}),
+						PublicIP:     types.StringValue("1.2.3.4"),
+						Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+						Routed: types.BoolValue(true),
+					},
+					{
+						NetworkId:    types.StringValue("nid-2"),
+						Name:         types.StringNull(),
+						IPv4Prefixes: types.ListNull(types.StringType),
+						IPv6Prefixes: types.ListNull(types.StringType),
+						PublicIP:     types.StringNull(),
+						Labels:       types.MapNull(types.StringType),
+						Routed:       types.BoolNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_network_id",
+			&iaas.NetworkListResponse{
+				Items: &[]iaas.Network{
+					{
+						Name: utils.Ptr("name"),
+					},
+				},
+			},
+			networksDataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			networksDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &networksDataSourceModel{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapNetworksDataSourceFields(context.Background(), tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
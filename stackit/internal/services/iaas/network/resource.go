@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,11 +33,13 @@ import (
 var (
 	_ resource.Resource                = &networkResource{}
 	_ resource.ResourceWithConfigure   = &networkResource{}
+	_ resource.ResourceWithModifyPlan  = &networkResource{}
 	_ resource.ResourceWithImportState = &networkResource{}
 )
 
 type Model struct {
 	Id               types.String `tfsdk:"id"` // needed by TF
+	ImportId         types.String `tfsdk:"import_id"`
 	ProjectId        types.String `tfsdk:"project_id"`
 	NetworkId        types.String `tfsdk:"network_id"`
 	Name             types.String `tfsdk:"name"`
@@ -59,6 +60,7 @@ type Model struct {
 	Routed           types.Bool   `tfsdk:"routed"`
 	NoIPv4Gateway    types.Bool   `tfsdk:"no_ipv4_gateway"`
 	NoIPv6Gateway    types.Bool   `tfsdk:"no_ipv6_gateway"`
+	State            types.String `tfsdk:"state"`
 }
 
 // NewNetworkResource is a helper function to simplify the provider implementation.
@@ -68,7 +70,10 @@ func NewNetworkResource() resource.Resource {
 
 // networkResource is the resource implementation.
 type networkResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
+	providerData  core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -93,12 +98,12 @@ func (r *networkResource) Configure(ctx context.Context, req resource.ConfigureR
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -110,6 +115,9 @@ func (r *networkResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "IaaS client configured")
 }
 
@@ -136,9 +144,18 @@ func (r *networkResource) ConfigValidators(_ context.Context) []resource.ConfigV
 			path.MatchRoot("no_ipv6_gateway"),
 			path.MatchRoot("ipv6_gateway"),
 		),
+		validate.IPWithinPrefix("ipv4_gateway", "ipv4_prefix"),
+		validate.IPWithinPrefix("ipv6_gateway", "ipv6_prefix"),
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *networkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -151,6 +168,13 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the network is associated.",
 				Required:    true,
@@ -196,7 +220,7 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"ipv4_gateway": schema.StringAttribute{
-				Description: "The IPv4 gateway of a network. If not specified, the first IP of the network will be assigned as the gateway.",
+				Description: "The IPv4 gateway of a network. If not specified, the first IP of the network will be assigned as the gateway. If `ipv4_prefix` is set, the gateway must be part of it.",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
@@ -248,7 +272,7 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"ipv6_gateway": schema.StringAttribute{
-				Description: "The IPv6 gateway of a network. If not specified, the first IP of the network will be assigned as the gateway.",
+				Description: "The IPv6 gateway of a network. If not specified, the first IP of the network will be assigned as the gateway. If `ipv6_prefix` is set, the gateway must be part of it.",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
@@ -290,10 +314,16 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"state": schema.StringAttribute{
+				Description: "The state of the network, e.g. `CREATED`.",
+				Computed:    true,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 			"routed": schema.BoolAttribute{
 				Description: "If set to `true`, the network is routed and therefore accessible from other networks.",
@@ -332,12 +362,12 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 
 	network, err := r.client.CreateNetwork(ctx, projectId).CreateNetworkPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating network", err, req.Plan)
 		return
 	}
 
 	networkId := *network.NetworkId
-	network, err = wait.CreateNetworkWaitHandler(ctx, r.client, projectId, networkId).WaitWithContext(ctx)
+	network, err = utils.Wait(ctx, wait.CreateNetworkWaitHandler(ctx, r.client, projectId, networkId), "network creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", fmt.Sprintf("Network creation waiting: %v", err))
 		return
@@ -346,6 +376,7 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	ctx = tflog.SetField(ctx, "network_id", networkId)
 
 	// Map response body to schema
+	network.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, network.Labels, model.Labels)
 	err = mapFields(ctx, network, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", fmt.Sprintf("Processing API payload: %v", err))
@@ -385,6 +416,7 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Map response body to schema
+	networkResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, networkResp.Labels, model.Labels)
 	err = mapFields(ctx, networkResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network", fmt.Sprintf("Processing API payload: %v", err))
@@ -430,15 +462,16 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update existing network
 	err = r.client.PartialUpdateNetwork(ctx, projectId, networkId).PartialUpdateNetworkPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating network", err, req.Plan)
 		return
 	}
-	waitResp, err := wait.UpdateNetworkWaitHandler(ctx, r.client, projectId, networkId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.UpdateNetworkWaitHandler(ctx, r.client, projectId, networkId), "network update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Network update waiting: %v", err))
 		return
 	}
 
+	waitResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, waitResp.Labels, model.Labels)
 	err = mapFields(ctx, waitResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Processing API payload: %v", err))
@@ -473,7 +506,7 @@ func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteNetworkWaitHandler(ctx, r.client, projectId, networkId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteNetworkWaitHandler(ctx, r.client, projectId, networkId), "network deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network", fmt.Sprintf("Network deletion waiting: %v", err))
 		return
@@ -529,19 +562,11 @@ func mapFields(ctx context.Context, networkResp *iaas.Network, model *Model) err
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if networkResp.Labels != nil && len(*networkResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *networkResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, networkResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	// IPv4
@@ -639,6 +664,7 @@ func mapFields(ctx context.Context, networkResp *iaas.Network, model *Model) err
 	model.Name = types.StringPointerValue(networkResp.Name)
 	model.PublicIP = types.StringPointerValue(networkResp.PublicIp)
 	model.Labels = labels
+	model.State = types.StringPointerValue(networkResp.State)
 	model.Routed = types.BoolPointerValue(networkResp.Routed)
 
 	return nil
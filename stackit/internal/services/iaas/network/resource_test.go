@@ -31,6 +31,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,nid"),
+				ImportId:         types.StringValue("pid,nid"),
 				ProjectId:        types.StringValue("pid"),
 				NetworkId:        types.StringValue("nid"),
 				Name:             types.StringNull(),
@@ -87,6 +88,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:        types.StringValue("pid,nid"),
+				ImportId:  types.StringValue("pid,nid"),
 				ProjectId: types.StringValue("pid"),
 				NetworkId: types.StringValue("nid"),
 				Name:      types.StringValue("name"),
@@ -149,6 +151,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:              types.StringValue("pid,nid"),
+				ImportId:        types.StringValue("pid,nid"),
 				ProjectId:       types.StringValue("pid"),
 				NetworkId:       types.StringValue("nid"),
 				Name:            types.StringNull(),
@@ -187,6 +190,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:              types.StringValue("pid,nid"),
+				ImportId:        types.StringValue("pid,nid"),
 				ProjectId:       types.StringValue("pid"),
 				NetworkId:       types.StringValue("nid"),
 				Name:            types.StringNull(),
@@ -222,6 +226,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,nid"),
+				ImportId:         types.StringValue("pid,nid"),
 				ProjectId:        types.StringValue("pid"),
 				NetworkId:        types.StringValue("nid"),
 				Name:             types.StringNull(),
@@ -262,6 +267,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,nid"),
+				ImportId:         types.StringValue("pid,nid"),
 				ProjectId:        types.StringValue("pid"),
 				NetworkId:        types.StringValue("nid"),
 				Name:             types.StringNull(),
@@ -291,6 +297,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,nid"),
+				ImportId:         types.StringValue("pid,nid"),
 				ProjectId:        types.StringValue("pid"),
 				NetworkId:        types.StringValue("nid"),
 				Name:             types.StringNull(),
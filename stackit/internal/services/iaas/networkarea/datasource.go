@@ -70,12 +70,12 @@ func (d *networkAreaDataSource) Configure(ctx context.Context, req datasource.Co
 	}
 
 	if providerData.IaaSCustomEndpoint != "" {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -99,6 +99,10 @@ func (d *networkAreaDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: "Terraform's internal resource ID. It is structured as \"`organization_id`,`network_area_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"organization_id": schema.StringAttribute{
 				Description: "STACKIT organization ID to which the network area is associated.",
 				Required:    true,
@@ -42,10 +42,12 @@ var (
 	_ resource.Resource                = &networkAreaResource{}
 	_ resource.ResourceWithConfigure   = &networkAreaResource{}
 	_ resource.ResourceWithImportState = &networkAreaResource{}
+	_ resource.ResourceWithModifyPlan  = &networkAreaResource{}
 )
 
 type Model struct {
 	Id                  types.String `tfsdk:"id"` // needed by TF
+	ImportId            types.String `tfsdk:"import_id"`
 	OrganizationId      types.String `tfsdk:"organization_id"`
 	NetworkAreaId       types.String `tfsdk:"network_area_id"`
 	Name                types.String `tfsdk:"name"`
@@ -78,7 +80,9 @@ func NewNetworkAreaResource() resource.Resource {
 
 // networkResource is the resource implementation.
 type networkAreaResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -111,12 +115,12 @@ func (r *networkAreaResource) Configure(ctx context.Context, req resource.Config
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -128,9 +132,17 @@ func (r *networkAreaResource) Configure(ctx context.Context, req resource.Config
 	}
 
 	r.client = apiClient
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "IaaS client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to add the default labels of the provider to the planned labels.
+func (r *networkAreaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	internalUtils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *networkAreaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -144,6 +156,13 @@ func (r *networkAreaResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"organization_id": schema.StringAttribute{
 				Description: "STACKIT organization ID to which the network area is associated.",
 				Required:    true,
@@ -250,6 +269,8 @@ func (r *networkAreaResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 		},
 	}
@@ -278,11 +299,11 @@ func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateReq
 	// Create new network area
 	area, err := r.client.CreateNetworkArea(ctx, organizationId).CreateNetworkAreaPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating network area", err, req.Plan)
 		return
 	}
 
-	networkArea, err := wait.CreateNetworkAreaWaitHandler(ctx, r.client, organizationId, *area.AreaId).WaitWithContext(context.Background())
+	networkArea, err := internalUtils.Wait(context.Background(), wait.CreateNetworkAreaWaitHandler(ctx, r.client, organizationId, *area.AreaId), "network area creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area", fmt.Sprintf("Network area creation waiting: %v", err))
 		return
@@ -293,6 +314,7 @@ func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateReq
 	networkAreaRanges := networkArea.Ipv4.NetworkRanges
 
 	// Map response body to schema
+	networkArea.Labels = internalUtils.RemoveIgnoredLabels(r.ignoredLabels, networkArea.Labels, model.Labels)
 	err = mapFields(ctx, networkArea, networkAreaRanges, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area", fmt.Sprintf("Processing API payload: %v", err))
@@ -334,6 +356,7 @@ func (r *networkAreaResource) Read(ctx context.Context, req resource.ReadRequest
 	networkAreaRanges := networkAreaResp.Ipv4.NetworkRanges
 
 	// Map response body to schema
+	networkAreaResp.Labels = internalUtils.RemoveIgnoredLabels(r.ignoredLabels, networkAreaResp.Labels, model.Labels)
 	err = mapFields(ctx, networkAreaResp, networkAreaRanges, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area", fmt.Sprintf("Processing API payload: %v", err))
@@ -388,10 +411,10 @@ func (r *networkAreaResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update existing network
 	_, err = r.client.PartialUpdateNetworkArea(ctx, organizationId, networkAreaId).PartialUpdateNetworkAreaPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating network area", err, req.Plan)
 		return
 	}
-	waitResp, err := wait.UpdateNetworkAreaWaitHandler(ctx, r.client, organizationId, networkAreaId).WaitWithContext(ctx)
+	waitResp, err := internalUtils.Wait(ctx, wait.UpdateNetworkAreaWaitHandler(ctx, r.client, organizationId, networkAreaId), "network area update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", fmt.Sprintf("Network area update waiting: %v", err))
 		return
@@ -417,6 +440,7 @@ func (r *networkAreaResource) Update(ctx context.Context, req resource.UpdateReq
 
 	networkAreaRanges := networkAreaResp.Ipv4.NetworkRanges
 
+	waitResp.Labels = internalUtils.RemoveIgnoredLabels(r.ignoredLabels, waitResp.Labels, model.Labels)
 	err = mapFields(ctx, waitResp, networkAreaRanges, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", fmt.Sprintf("Processing API payload: %v", err))
@@ -462,7 +486,7 @@ func (r *networkAreaResource) Delete(ctx context.Context, req resource.DeleteReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteNetworkAreaWaitHandler(ctx, r.client, organizationId, networkAreaId).WaitWithContext(ctx)
+	_, err = internalUtils.Wait(ctx, wait.DeleteNetworkAreaWaitHandler(ctx, r.client, organizationId, networkAreaId), "network area deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area", fmt.Sprintf("Network area deletion waiting: %v", err))
 		return
@@ -518,6 +542,7 @@ func mapFields(ctx context.Context, networkAreaResp *iaas.NetworkArea, networkAr
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	if networkAreaResp.Ipv4 == nil || networkAreaResp.Ipv4.DefaultNameservers == nil {
 		model.DefaultNameservers = types.ListNull(types.StringType)
@@ -543,18 +568,9 @@ func mapFields(ctx context.Context, networkAreaResp *iaas.NetworkArea, networkAr
 		return fmt.Errorf("mapping network ranges: %w", err)
 	}
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if networkAreaResp.Labels != nil && len(*networkAreaResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *networkAreaResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := internalUtils.MapLabels(ctx, networkAreaResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	model.NetworkAreaId = types.StringValue(networkAreaId)
@@ -68,6 +68,7 @@ func TestMapFields(t *testing.T) {
 
 			Model{
 				Id:                  types.StringValue("oid,naid"),
+				ImportId:            types.StringValue("oid,naid"),
 				OrganizationId:      types.StringValue("oid"),
 				NetworkAreaId:       types.StringValue("naid"),
 				Name:                types.StringNull(),
@@ -135,6 +136,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:             types.StringValue("oid,naid"),
+				ImportId:       types.StringValue("oid,naid"),
 				OrganizationId: types.StringValue("oid"),
 				NetworkAreaId:  types.StringValue("naid"),
 				Name:           types.StringValue("name"),
@@ -208,6 +210,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:             types.StringValue("oid,naid"),
+				ImportId:       types.StringValue("oid,naid"),
 				OrganizationId: types.StringValue("oid"),
 				NetworkAreaId:  types.StringValue("naid"),
 				Name:           types.StringValue("name"),
@@ -278,6 +281,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:             types.StringValue("oid,naid"),
+				ImportId:       types.StringValue("oid,naid"),
 				OrganizationId: types.StringValue("oid"),
 				NetworkAreaId:  types.StringValue("naid"),
 				DefaultNameservers: types.ListValueMust(types.StringType, []attr.Value{
@@ -330,6 +334,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("oid,naid"),
+				ImportId:           types.StringValue("oid,naid"),
 				OrganizationId:     types.StringValue("oid"),
 				NetworkAreaId:      types.StringValue("naid"),
 				DefaultNameservers: types.ListNull(types.StringType),
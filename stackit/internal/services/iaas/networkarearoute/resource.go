@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +20,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -34,10 +34,12 @@ var (
 	_ resource.Resource                = &networkAreaRouteResource{}
 	_ resource.ResourceWithConfigure   = &networkAreaRouteResource{}
 	_ resource.ResourceWithImportState = &networkAreaRouteResource{}
+	_ resource.ResourceWithModifyPlan  = &networkAreaRouteResource{}
 )
 
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
+	ImportId           types.String `tfsdk:"import_id"`
 	OrganizationId     types.String `tfsdk:"organization_id"`
 	NetworkAreaId      types.String `tfsdk:"network_area_id"`
 	NetworkAreaRouteId types.String `tfsdk:"network_area_route_id"`
@@ -53,7 +55,9 @@ func NewNetworkAreaRouteResource() resource.Resource {
 
 // networkResource is the resource implementation.
 type networkAreaRouteResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -86,12 +90,12 @@ func (r *networkAreaRouteResource) Configure(ctx context.Context, req resource.C
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -103,9 +107,17 @@ func (r *networkAreaRouteResource) Configure(ctx context.Context, req resource.C
 	}
 
 	r.client = apiClient
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "IaaS client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to add the default labels of the provider to the planned labels.
+func (r *networkAreaRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *networkAreaRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -119,6 +131,13 @@ func (r *networkAreaRouteResource) Schema(_ context.Context, _ resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"organization_id": schema.StringAttribute{
 				Description: "STACKIT organization ID to which the network area is associated.",
 				Required:    true,
@@ -178,6 +197,8 @@ func (r *networkAreaRouteResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 		},
 	}
@@ -208,7 +229,7 @@ func (r *networkAreaRouteResource) Create(ctx context.Context, req resource.Crea
 	// Create new network area route
 	routes, err := r.client.CreateNetworkAreaRoute(ctx, organizationId, networkAreaId).CreateNetworkAreaRoutePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area route", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating network area route", err, req.Plan)
 		return
 	}
 	if routes.Items == nil || len(*routes.Items) == 0 {
@@ -229,6 +250,7 @@ func (r *networkAreaRouteResource) Create(ctx context.Context, req resource.Crea
 	ctx = tflog.SetField(ctx, "network_area_route_id", routeId)
 
 	// Map response body to schema
+	route.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, route.Labels, model.Labels)
 	err = mapFields(ctx, &route, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area route.", fmt.Sprintf("Processing API payload: %v", err))
@@ -270,6 +292,7 @@ func (r *networkAreaRouteResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Map response body to schema
+	networkAreaRouteResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, networkAreaRouteResp.Labels, model.Labels)
 	err = mapFields(ctx, networkAreaRouteResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area route", fmt.Sprintf("Processing API payload: %v", err))
@@ -345,10 +368,11 @@ func (r *networkAreaRouteResource) Update(ctx context.Context, req resource.Upda
 	// Update existing network area route
 	networkAreaRouteResp, err := r.client.UpdateNetworkAreaRoute(ctx, organizationId, networkAreaId, networkAreaRouteId).UpdateNetworkAreaRoutePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area route", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating network area route", err, req.Plan)
 		return
 	}
 
+	networkAreaRouteResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, networkAreaRouteResp.Labels, model.Labels)
 	err = mapFields(ctx, networkAreaRouteResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area route", fmt.Sprintf("Processing API payload: %v", err))
@@ -413,19 +437,11 @@ func mapFields(ctx context.Context, networkAreaRoute *iaas.Route, model *Model)
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if networkAreaRoute.Labels != nil && len(*networkAreaRoute.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *networkAreaRoute.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, networkAreaRoute.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	model.NetworkAreaRouteId = types.StringValue(networkAreaRouteId)
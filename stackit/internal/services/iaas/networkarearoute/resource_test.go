@@ -29,6 +29,7 @@ func TestMapFields(t *testing.T) {
 			&iaas.Route{},
 			Model{
 				Id:                 types.StringValue("oid,naid,narid"),
+				ImportId:           types.StringValue("oid,naid,narid"),
 				OrganizationId:     types.StringValue("oid"),
 				NetworkAreaId:      types.StringValue("naid"),
 				NetworkAreaRouteId: types.StringValue("narid"),
@@ -54,6 +55,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("oid,naid,narid"),
+				ImportId:           types.StringValue("oid,naid,narid"),
 				OrganizationId:     types.StringValue("oid"),
 				NetworkAreaId:      types.StringValue("naid"),
 				NetworkAreaRouteId: types.StringValue("narid"),
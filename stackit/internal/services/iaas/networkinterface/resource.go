@@ -38,10 +38,12 @@ var (
 	_ resource.Resource                = &networkInterfaceResource{}
 	_ resource.ResourceWithConfigure   = &networkInterfaceResource{}
 	_ resource.ResourceWithImportState = &networkInterfaceResource{}
+	_ resource.ResourceWithModifyPlan  = &networkInterfaceResource{}
 )
 
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
+	ImportId           types.String `tfsdk:"import_id"`
 	ProjectId          types.String `tfsdk:"project_id"`
 	NetworkId          types.String `tfsdk:"network_id"`
 	NetworkInterfaceId types.String `tfsdk:"network_interface_id"`
@@ -63,7 +65,9 @@ func NewNetworkInterfaceResource() resource.Resource {
 
 // networkResource is the resource implementation.
 type networkInterfaceResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -96,12 +100,12 @@ func (r *networkInterfaceResource) Configure(ctx context.Context, req resource.C
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -113,9 +117,17 @@ func (r *networkInterfaceResource) Configure(ctx context.Context, req resource.C
 	}
 
 	r.client = apiClient
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "iaas client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to add the default labels of the provider to the planned labels.
+func (r *networkInterfaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	typeOptions := []string{"server", "metadata", "gateway"}
@@ -131,6 +143,13 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the network is associated.",
 				Required:    true,
@@ -211,6 +230,8 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "Labels are key-value string pairs which can be attached to a network interface.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 			"mac": schema.StringAttribute{
 				Description: "The MAC address of network interface.",
@@ -273,7 +294,7 @@ func (r *networkInterfaceResource) Create(ctx context.Context, req resource.Crea
 	// Create new network interface
 	networkInterface, err := r.client.CreateNic(ctx, projectId, networkId).CreateNicPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network interface", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating network interface", err, req.Plan)
 		return
 	}
 
@@ -282,6 +303,7 @@ func (r *networkInterfaceResource) Create(ctx context.Context, req resource.Crea
 	ctx = tflog.SetField(ctx, "network_interface_id", networkInterfaceId)
 
 	// Map response body to schema
+	networkInterface.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, networkInterface.Labels, model.Labels)
 	err = mapFields(ctx, networkInterface, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network interface", fmt.Sprintf("Processing API payload: %v", err))
@@ -323,6 +345,7 @@ func (r *networkInterfaceResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Map response body to schema
+	networkInterfaceResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, networkInterfaceResp.Labels, model.Labels)
 	err = mapFields(ctx, networkInterfaceResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network interface", fmt.Sprintf("Processing API payload: %v", err))
@@ -370,10 +393,11 @@ func (r *networkInterfaceResource) Update(ctx context.Context, req resource.Upda
 	// Update existing network
 	nicResp, err := r.client.UpdateNic(ctx, projectId, networkId, networkInterfaceId).UpdateNicPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network interface", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating network interface", err, req.Plan)
 		return
 	}
 
+	nicResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, nicResp.Labels, model.Labels)
 	err = mapFields(ctx, nicResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network interface", fmt.Sprintf("Processing API payload: %v", err))
@@ -465,6 +489,7 @@ func mapFields(ctx context.Context, networkInterfaceResp *iaas.NIC, model *Model
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	respAllowedAddresses := []string{}
 	var diags diag.Diagnostics
@@ -518,18 +543,9 @@ func mapFields(ctx context.Context, networkInterfaceResp *iaas.NIC, model *Model
 		model.SecurityGroupIds = securityGroupsTF
 	}
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if networkInterfaceResp.Labels != nil && len(*networkInterfaceResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *networkInterfaceResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, networkInterfaceResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	networkInterfaceName := types.StringNull()
@@ -31,6 +31,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,nid,nicid"),
+				ImportId:           types.StringValue("pid,nid,nicid"),
 				ProjectId:          types.StringValue("pid"),
 				NetworkId:          types.StringValue("nid"),
 				NetworkInterfaceId: types.StringValue("nicid"),
@@ -78,6 +79,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,nid,nicid"),
+				ImportId:           types.StringValue("pid,nid,nicid"),
 				ProjectId:          types.StringValue("pid"),
 				NetworkId:          types.StringValue("nid"),
 				NetworkInterfaceId: types.StringValue("nicid"),
@@ -118,6 +120,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,nid,nicid"),
+				ImportId:           types.StringValue("pid,nid,nicid"),
 				ProjectId:          types.StringValue("pid"),
 				NetworkId:          types.StringValue("nid"),
 				NetworkInterfaceId: types.StringValue("nicid"),
@@ -144,6 +147,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,nid,nicid"),
+				ImportId:           types.StringValue("pid,nid,nicid"),
 				ProjectId:          types.StringValue("pid"),
 				NetworkId:          types.StringValue("nid"),
 				NetworkInterfaceId: types.StringValue("nicid"),
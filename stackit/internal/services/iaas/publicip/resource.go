@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +20,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -34,10 +34,12 @@ var (
 	_ resource.Resource                = &publicIpResource{}
 	_ resource.ResourceWithConfigure   = &publicIpResource{}
 	_ resource.ResourceWithImportState = &publicIpResource{}
+	_ resource.ResourceWithModifyPlan  = &publicIpResource{}
 )
 
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
+	ImportId           types.String `tfsdk:"import_id"`
 	ProjectId          types.String `tfsdk:"project_id"`
 	PublicIpId         types.String `tfsdk:"public_ip_id"`
 	Ip                 types.String `tfsdk:"ip"`
@@ -52,7 +54,9 @@ func NewPublicIpResource() resource.Resource {
 
 // publicIpResource is the resource implementation.
 type publicIpResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -85,12 +89,12 @@ func (r *publicIpResource) Configure(ctx context.Context, req resource.Configure
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -102,9 +106,17 @@ func (r *publicIpResource) Configure(ctx context.Context, req resource.Configure
 	}
 
 	r.client = apiClient
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "iaas client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to add the default labels of the provider to the planned labels.
+func (r *publicIpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *publicIpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -118,6 +130,13 @@ func (r *publicIpResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the public IP is associated.",
 				Required:    true,
@@ -162,6 +181,8 @@ func (r *publicIpResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 		},
 	}
@@ -191,13 +212,14 @@ func (r *publicIpResource) Create(ctx context.Context, req resource.CreateReques
 
 	publicIp, err := r.client.CreatePublicIP(ctx, projectId).CreatePublicIPPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating public IP", err, req.Plan)
 		return
 	}
 
 	ctx = tflog.SetField(ctx, "public_ip_id", *publicIp.Id)
 
 	// Map response body to schema
+	publicIp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, publicIp.Labels, model.Labels)
 	err = mapFields(ctx, publicIp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Processing API payload: %v", err))
@@ -237,6 +259,7 @@ func (r *publicIpResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Map response body to schema
+	publicIpResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, publicIpResp.Labels, model.Labels)
 	err = mapFields(ctx, publicIpResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IP", fmt.Sprintf("Processing API payload: %v", err))
@@ -282,10 +305,11 @@ func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update existing public IP
 	updatedPublicIp, err := r.client.UpdatePublicIP(ctx, projectId, publicIpId).UpdatePublicIPPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating public IP", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating public IP", err, req.Plan)
 		return
 	}
 
+	updatedPublicIp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, updatedPublicIp.Labels, model.Labels)
 	err = mapFields(ctx, updatedPublicIp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating public IP", fmt.Sprintf("Processing API payload: %v", err))
@@ -371,19 +395,11 @@ func mapFields(ctx context.Context, publicIpResp *iaas.PublicIp, model *Model) e
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if publicIpResp.Labels != nil && len(*publicIpResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *publicIpResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, publicIpResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	model.PublicIpId = types.StringValue(publicIpId)
@@ -31,6 +31,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,pipid"),
+				ImportId:           types.StringValue("pid,pipid"),
 				ProjectId:          types.StringValue("pid"),
 				PublicIpId:         types.StringValue("pipid"),
 				Ip:                 types.StringNull(),
@@ -55,6 +56,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,pipid"),
+				ImportId:   types.StringValue("pid,pipid"),
 				ProjectId:  types.StringValue("pid"),
 				PublicIpId: types.StringValue("pipid"),
 				Ip:         types.StringValue("ip"),
@@ -78,6 +80,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,pipid"),
+				ImportId:           types.StringValue("pid,pipid"),
 				ProjectId:          types.StringValue("pid"),
 				PublicIpId:         types.StringValue("pipid"),
 				Ip:                 types.StringNull(),
@@ -97,6 +100,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,pipid"),
+				ImportId:           types.StringValue("pid,pipid"),
 				ProjectId:          types.StringValue("pid"),
 				PublicIpId:         types.StringValue("pipid"),
 				Ip:                 types.StringNull(),
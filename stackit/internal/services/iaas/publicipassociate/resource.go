@@ -37,6 +37,7 @@ var (
 
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
+	ImportId           types.String `tfsdk:"import_id"`
 	ProjectId          types.String `tfsdk:"project_id"`
 	PublicIpId         types.String `tfsdk:"public_ip_id"`
 	Ip                 types.String `tfsdk:"ip"`
@@ -83,12 +84,12 @@ func (r *publicIpAssociateResource) Configure(ctx context.Context, req resource.
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -126,6 +127,13 @@ func (r *publicIpAssociateResource) Schema(_ context.Context, _ resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the public IP is associated.",
 				Required:    true,
@@ -348,6 +356,7 @@ func mapFields(publicIpResp *iaas.PublicIp, model *Model) error {
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	model.PublicIpId = types.StringValue(publicIpId)
 	model.Ip = types.StringPointerValue(publicIpResp.Ip)
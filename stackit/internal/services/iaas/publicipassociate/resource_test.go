@@ -30,6 +30,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,pipid,nicid"),
+				ImportId:           types.StringValue("pid,pipid,nicid"),
 				ProjectId:          types.StringValue("pid"),
 				PublicIpId:         types.StringValue("pipid"),
 				Ip:                 types.StringNull(),
@@ -51,6 +52,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,pipid,nicid"),
+				ImportId:           types.StringValue("pid,pipid,nicid"),
 				ProjectId:          types.StringValue("pid"),
 				PublicIpId:         types.StringValue("pipid"),
 				Ip:                 types.StringValue("ip"),
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,6 +19,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -25,9 +30,47 @@ var securityGroupDataSourceBetaCheckDone bool
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &securityGroupDataSource{}
+	_ datasource.DataSource                     = &securityGroupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &securityGroupDataSource{}
 )
 
+// DataSourceModel maps the data source schema data.
+type DataSourceModel struct {
+	Model
+	Rules types.List `tfsdk:"rules"`
+}
+
+// Types corresponding to DataSourceModel.Rules[i]
+var ruleTypes = map[string]attr.Type{
+	"security_group_rule_id":   types.StringType,
+	"direction":                types.StringType,
+	"description":              types.StringType,
+	"ether_type":               types.StringType,
+	"ip_range":                 types.StringType,
+	"remote_security_group_id": types.StringType,
+	"icmp_parameters":          types.ObjectType{AttrTypes: icmpParametersTypes},
+	"port_range":               types.ObjectType{AttrTypes: portRangeTypes},
+	"protocol":                 types.ObjectType{AttrTypes: protocolTypes},
+}
+
+// Types corresponding to the rule's icmp_parameters
+var icmpParametersTypes = map[string]attr.Type{
+	"code": types.Int64Type,
+	"type": types.Int64Type,
+}
+
+// Types corresponding to the rule's port_range
+var portRangeTypes = map[string]attr.Type{
+	"max": types.Int64Type,
+	"min": types.Int64Type,
+}
+
+// Types corresponding to the rule's protocol
+var protocolTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"number": types.Int64Type,
+}
+
 // NewSecurityGroupDataSource is a helper function to simplify the provider implementation.
 func NewSecurityGroupDataSource() datasource.DataSource {
 	return &securityGroupDataSource{}
@@ -67,12 +110,12 @@ func (d *securityGroupDataSource) Configure(ctx context.Context, req datasource.
 	}
 
 	if providerData.IaaSCustomEndpoint != "" {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -96,6 +139,10 @@ func (r *securityGroupDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`security_group_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the security group is associated.",
 				Required:    true,
@@ -105,15 +152,17 @@ func (r *securityGroupDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				},
 			},
 			"security_group_id": schema.StringAttribute{
-				Description: "The security group ID.",
-				Required:    true,
+				Description: "The security group ID. Either `security_group_id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the security group.",
+				Description: "The name of the security group. Either `security_group_id` or `name` must be set. If `name` is set, it must match exactly one security group of the project.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": schema.StringAttribute{
@@ -129,35 +178,138 @@ func (r *securityGroupDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Description: "Configures if a security group is stateful or stateless. There can only be one type of security groups per network interface/server.",
 				Computed:    true,
 			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The rules of the security group.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"security_group_rule_id": schema.StringAttribute{
+							Description: "The security group rule ID.",
+							Computed:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "The direction of the traffic which the rule should match, either `ingress` or `egress`.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the security group rule.",
+							Computed:    true,
+						},
+						"ether_type": schema.StringAttribute{
+							Description: "The ethertype which the rule should match, e.g. `IPv4`.",
+							Computed:    true,
+						},
+						"ip_range": schema.StringAttribute{
+							Description: "The remote IP range which the rule should match.",
+							Computed:    true,
+						},
+						"remote_security_group_id": schema.StringAttribute{
+							Description: "The remote security group which the rule should match.",
+							Computed:    true,
+						},
+						"icmp_parameters": schema.SingleNestedAttribute{
+							Description: "ICMP Parameters.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"code": schema.Int64Attribute{
+									Description: "ICMP code.",
+									Computed:    true,
+								},
+								"type": schema.Int64Attribute{
+									Description: "ICMP type.",
+									Computed:    true,
+								},
+							},
+						},
+						"port_range": schema.SingleNestedAttribute{
+							Description: "The range of ports.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"max": schema.Int64Attribute{
+									Description: "The maximum port number.",
+									Computed:    true,
+								},
+								"min": schema.Int64Attribute{
+									Description: "The minimum port number.",
+									Computed:    true,
+								},
+							},
+						},
+						"protocol": schema.SingleNestedAttribute{
+							Description: "The internet protocol which the rule should match.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "The protocol name which the rule should match, e.g. `tcp`.",
+									Computed:    true,
+								},
+								"number": schema.Int64Attribute{
+									Description: "The protocol number which the rule should match.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ConfigValidators validates the data source configuration
+func (d *securityGroupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("security_group_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *securityGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model DataSourceModel
 	diags := req.Config.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	projectId := model.ProjectId.ValueString()
-	securityGroupId := model.SecurityGroupId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
-	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
 
-	securityGroupResp, err := d.client.GetSecurityGroup(ctx, projectId, securityGroupId).Execute()
-	if err != nil {
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
-		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+	var securityGroupResp *iaas.SecurityGroup
+	if model.SecurityGroupId.IsNull() {
+		name := model.Name.ValueString()
+		ctx = tflog.SetField(ctx, "name", name)
+
+		securityGroupsResp, err := d.client.ListSecurityGroups(ctx, projectId).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group", fmt.Sprintf("Calling API to list security groups: %v", err))
+			return
+		}
+		securityGroupResp, err = securityGroupByName(securityGroupsResp, name)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group", err.Error())
+			return
+		}
+	} else {
+		securityGroupId := model.SecurityGroupId.ValueString()
+		ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+		var err error
+		securityGroupResp, err = d.client.GetSecurityGroup(ctx, projectId, securityGroupId).Execute()
+		if err != nil {
+			oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+			if ok && oapiErr.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group", fmt.Sprintf("Calling API: %v", err))
 			return
 		}
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group", fmt.Sprintf("Calling API: %v", err))
-		return
 	}
 
-	err = mapFields(ctx, securityGroupResp, &model)
+	err := mapDataSourceFields(ctx, securityGroupResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -169,3 +321,100 @@ func (d *securityGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 	tflog.Info(ctx, "security group read")
 }
+
+// securityGroupByName returns the only security group of the list with the given name.
+// Security group names are not unique, so an error is returned if none or several security groups have the name.
+func securityGroupByName(securityGroupsResp *iaas.SecurityGroupListResponse, name string) (*iaas.SecurityGroup, error) {
+	if securityGroupsResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	return utils.FindOneByName(securityGroupsResp.Items, func(sg *iaas.SecurityGroup) *string { return sg.Name }, name, "security group", "security_group_id")
+}
+
+func mapDataSourceFields(ctx context.Context, securityGroupResp *iaas.SecurityGroup, model *DataSourceModel) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	err := mapFields(ctx, securityGroupResp, &model.Model)
+	if err != nil {
+		return err
+	}
+
+	if securityGroupResp.Rules == nil {
+		model.Rules = types.ListNull(types.ObjectType{AttrTypes: ruleTypes})
+		return nil
+	}
+	rules := []attr.Value{}
+	for i := range *securityGroupResp.Rules {
+		rule := &(*securityGroupResp.Rules)[i]
+		ruleTF, err := mapRule(rule)
+		if err != nil {
+			return fmt.Errorf("mapping rule %d: %w", i, err)
+		}
+		rules = append(rules, ruleTF)
+	}
+	rulesTF, diags := types.ListValue(types.ObjectType{AttrTypes: ruleTypes}, rules)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Rules = rulesTF
+	return nil
+}
+
+func mapRule(rule *iaas.SecurityGroupRule) (types.Object, error) {
+	if rule.Id == nil {
+		return types.ObjectNull(ruleTypes), fmt.Errorf("security group rule id not present")
+	}
+
+	icmpParameters := types.ObjectNull(icmpParametersTypes)
+	if rule.IcmpParameters != nil {
+		var diags diag.Diagnostics
+		icmpParameters, diags = types.ObjectValue(icmpParametersTypes, map[string]attr.Value{
+			"code": types.Int64PointerValue(rule.IcmpParameters.Code),
+			"type": types.Int64PointerValue(rule.IcmpParameters.Type),
+		})
+		if diags.HasError() {
+			return types.ObjectNull(ruleTypes), fmt.Errorf("mapping icmp parameters: %w", core.DiagsToError(diags))
+		}
+	}
+
+	portRange := types.ObjectNull(portRangeTypes)
+	if rule.PortRange != nil {
+		var diags diag.Diagnostics
+		portRange, diags = types.ObjectValue(portRangeTypes, map[string]attr.Value{
+			"max": types.Int64PointerValue(rule.PortRange.Max),
+			"min": types.Int64PointerValue(rule.PortRange.Min),
+		})
+		if diags.HasError() {
+			return types.ObjectNull(ruleTypes), fmt.Errorf("mapping port range: %w", core.DiagsToError(diags))
+		}
+	}
+
+	protocol := types.ObjectNull(protocolTypes)
+	if rule.Protocol != nil {
+		var diags diag.Diagnostics
+		protocol, diags = types.ObjectValue(protocolTypes, map[string]attr.Value{
+			"name":   types.StringPointerValue(rule.Protocol.Name),
+			"number": types.Int64PointerValue(rule.Protocol.Number),
+		})
+		if diags.HasError() {
+			return types.ObjectNull(ruleTypes), fmt.Errorf("mapping protocol: %w", core.DiagsToError(diags))
+		}
+	}
+
+	ruleTF, diags := types.ObjectValue(ruleTypes, map[string]attr.Value{
+		"security_group_rule_id":   types.StringPointerValue(rule.Id),
+		"direction":                types.StringPointerValue(rule.Direction),
+		"description":              types.StringPointerValue(rule.Description),
+		"ether_type":               types.StringPointerValue(rule.Ethertype),
+		"ip_range":                 types.StringPointerValue(rule.IpRange),
+		"remote_security_group_id": types.StringPointerValue(rule.RemoteSecurityGroupId),
+		"icmp_parameters":          icmpParameters,
+		"port_range":               portRange,
+		"protocol":                 protocol,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(ruleTypes), core.DiagsToError(diags)
+	}
+	return ruleTF, nil
+}
@@ -0,0 +1,235 @@
+package securitygroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestSecurityGroupByName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.SecurityGroupListResponse
+		name        string
+		expected    *iaas.SecurityGroup
+		isValid     bool
+	}{
+		{
+			"single_match",
+			&iaas.SecurityGroupListResponse{
+				Items: &[]iaas.SecurityGroup{
+					{Id: utils.Ptr("sgid-1"), Name: utils.Ptr("other")},
+					{Id: utils.Ptr("sgid-2"), Name: utils.Ptr("baseline")},
+					{Id: utils.Ptr("sgid-3")},
+				},
+			},
+			"baseline",
+			&iaas.SecurityGroup{Id: utils.Ptr("sgid-2"), Name: utils.Ptr("baseline")},
+			true,
+		},
+		{
+			"no_match",
+			&iaas.SecurityGroupListResponse{
+				Items: &[]iaas.SecurityGroup{
+					{Id: utils.Ptr("sgid-1"), Name: utils.Ptr("other")},
+				},
+			},
+			"baseline",
+			nil,
+			false,
+		},
+		{
+			"several_matches",
+			&iaas.SecurityGroupListResponse{
+				Items: &[]iaas.SecurityGroup{
+					{Id: utils.Ptr("sgid-1"), Name: utils.Ptr("baseline")},
+					{Id: utils.Ptr("sgid-2"), Name: utils.Ptr("baseline")},
+				},
+			},
+			"baseline",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"baseline",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := securityGroupByName(tt.input, tt.name)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.SecurityGroup
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_rules",
+			&iaas.SecurityGroup{
+				Id:   utils.Ptr("sgid"),
+				Name: utils.Ptr("baseline"),
+			},
+			DataSourceModel{
+				Model: Model{
+					Id:              types.StringValue("pid,sgid"),
+					ImportId:        types.StringValue("pid,sgid"),
+					ProjectId:       types.StringValue("pid"),
+					SecurityGroupId: types.StringValue("sgid"),
+					Name:            types.StringValue("baseline"),
+					Description:     types.StringNull(),
+					Labels:          types.MapNull(types.StringType),
+					Stateful:        types.BoolNull(),
+				},
+				Rules: types.ListNull(types.ObjectType{AttrTypes: ruleTypes}),
+			},
+			true,
+		},
+		{
+			"rules",
+			&iaas.SecurityGroup{
+				Id:       utils.Ptr("sgid"),
+				Name:     utils.Ptr("baseline"),
+				Stateful: utils.Ptr(true),
+				Rules: &[]iaas.SecurityGroupRule{
+					{
+						Id:          utils.Ptr("rid-1"),
+						Direction:   utils.Ptr("ingress"),
+						Description: utils.Ptr("ssh"),
+						Ethertype:   utils.Ptr("IPv4"),
+						IpRange:     utils.Ptr("10.0.0.0/8"),
+						PortRange: &iaas.PortRange{
+							Max: utils.Ptr(int64(22)),
+							Min: utils.Ptr(int64(22)),
+						},
+						Protocol: &iaas.Protocol{
+							Name:   utils.Ptr("tcp"),
+							Number: utils.Ptr(int64(6)),
+						},
+					},
+					{
+						Id:                    utils.Ptr("rid-2"),
+						Direction:             utils.Ptr("egress"),
+						RemoteSecurityGroupId: utils.Ptr("remote-sgid"),
+						IcmpParameters: &iaas.ICMPParameters{
+							Code: utils.Ptr(int64(0)),
+							Type: utils.Ptr(int64(8)),
+						},
+					},
+				},
+			},
+			DataSourceModel{
+				Model: Model{
+					Id:              types.StringValue("pid,sgid"),
+					ImportId:        types.StringValue("pid,sgid"),
+					ProjectId:       types.StringValue("pid"),
+					SecurityGroupId: types.StringValue("sgid"),
+					Name:            types.StringValue("baseline"),
+					Description:     types.StringNull(),
+					Labels:          types.MapNull(types.StringType),
+					Stateful:        types.BoolValue(true),
+				},
+				Rules: types.ListValueMust(types.ObjectType{AttrTypes: ruleTypes}, []attr.Value{
+					types.ObjectValueMust(ruleTypes, map[string]attr.Value{
+						"security_group_rule_id":   types.StringValue("rid-1"),
+						"direction":                types.StringValue("ingress"),
+						"description":              types.StringValue("ssh"),
+						"ether_type":               types.StringValue("IPv4"),
+						"ip_range":                 types.StringValue("10.0.0.0/8"),
+						"remote_security_group_id": types.StringNull(),
+						"icmp_parameters":          types.ObjectNull(icmpParametersTypes),
+						"port_range": types.ObjectValueMust(portRangeTypes, map[string]attr.Value{
+							"max": types.Int64Value(22),
+							"min": types.Int64Value(22),
+						}),
+						"protocol": types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+							"name":   types.StringValue("tcp"),
+							"number": types.Int64Value(6),
+						}),
+					}),
+					types.ObjectValueMust(ruleTypes, map[string]attr.Value{
+						"security_group_rule_id":   types.StringValue("rid-2"),
+						"direction":                types.StringValue("egress"),
+						"description":              types.StringNull(),
+						"ether_type":               types.StringNull(),
+						"ip_range":                 types.StringNull(),
+						"remote_security_group_id": types.StringValue("remote-sgid"),
+						"icmp_parameters": types.ObjectValueMust(icmpParametersTypes, map[string]attr.Value{
+							"code": types.Int64Value(0),
+							"type": types.Int64Value(8),
+						}),
+						"port_range": types.ObjectNull(portRangeTypes),
+						"protocol":   types.ObjectNull(protocolTypes),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"no_rule_id",
+			&iaas.SecurityGroup{
+				Id: utils.Ptr("sgid"),
+				Rules: &[]iaas.SecurityGroupRule{
+					{
+						Direction: utils.Ptr("ingress"),
+					},
+				},
+			},
+			DataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &DataSourceModel{
+				Model: Model{
+					ProjectId: types.StringValue("pid"),
+				},
+			}
+			err := mapDataSourceFields(context.Background(), tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,6 +23,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -37,10 +37,12 @@ var (
 	_ resource.Resource                = &securityGroupResource{}
 	_ resource.ResourceWithConfigure   = &securityGroupResource{}
 	_ resource.ResourceWithImportState = &securityGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &securityGroupResource{}
 )
 
 type Model struct {
 	Id              types.String `tfsdk:"id"` // needed by TF
+	ImportId        types.String `tfsdk:"import_id"`
 	ProjectId       types.String `tfsdk:"project_id"`
 	SecurityGroupId types.String `tfsdk:"security_group_id"`
 	Name            types.String `tfsdk:"name"`
@@ -56,7 +58,9 @@ func NewSecurityGroupResource() resource.Resource {
 
 // securityGroupResource is the resource implementation.
 type securityGroupResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
 }
 
 // Metadata returns the resource type name.
@@ -89,12 +93,12 @@ func (r *securityGroupResource) Configure(ctx context.Context, req resource.Conf
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -106,9 +110,17 @@ func (r *securityGroupResource) Configure(ctx context.Context, req resource.Conf
 	}
 
 	r.client = apiClient
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "iaas client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to add the default labels of the provider to the planned labels.
+func (r *securityGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *securityGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -122,6 +134,13 @@ func (r *securityGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the security group is associated.",
 				Required:    true,
@@ -171,6 +190,8 @@ func (r *securityGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 			"stateful": schema.BoolAttribute{
 				Description: "Configures if a security group is stateful or stateless. There can only be one type of security groups per network interface/server.",
@@ -209,7 +230,7 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 
 	securityGroup, err := r.client.CreateSecurityGroup(ctx, projectId).CreateSecurityGroupPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating security group", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating security group", err, req.Plan)
 		return
 	}
 
@@ -218,6 +239,7 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
 
 	// Map response body to schema
+	securityGroup.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, securityGroup.Labels, model.Labels)
 	err = mapFields(ctx, securityGroup, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating security group", fmt.Sprintf("Processing API payload: %v", err))
@@ -257,6 +279,7 @@ func (r *securityGroupResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Map response body to schema
+	securityGroupResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, securityGroupResp.Labels, model.Labels)
 	err = mapFields(ctx, securityGroupResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group", fmt.Sprintf("Processing API payload: %v", err))
@@ -302,10 +325,11 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 	// Update existing security group
 	updatedSecurityGroup, err := r.client.UpdateSecurityGroup(ctx, projectId, securityGroupId).UpdateSecurityGroupPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating security group", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating security group", err, req.Plan)
 		return
 	}
 
+	updatedSecurityGroup.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, updatedSecurityGroup.Labels, model.Labels)
 	err = mapFields(ctx, updatedSecurityGroup, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating security group", fmt.Sprintf("Processing API payload: %v", err))
@@ -391,19 +415,11 @@ func mapFields(ctx context.Context, securityGroupResp *iaas.SecurityGroup, model
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if securityGroupResp.Labels != nil && len(*securityGroupResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *securityGroupResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, securityGroupResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	model.SecurityGroupId = types.StringValue(securityGroupId)
@@ -30,6 +30,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:              types.StringValue("pid,sgid"),
+				ImportId:        types.StringValue("pid,sgid"),
 				ProjectId:       types.StringValue("pid"),
 				SecurityGroupId: types.StringValue("sgid"),
 				Name:            types.StringNull(),
@@ -57,6 +58,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:              types.StringValue("pid,sgid"),
+				ImportId:        types.StringValue("pid,sgid"),
 				ProjectId:       types.StringValue("pid"),
 				SecurityGroupId: types.StringValue("sgid"),
 				Name:            types.StringValue("name"),
@@ -80,6 +82,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:              types.StringValue("pid,sgid"),
+				ImportId:        types.StringValue("pid,sgid"),
 				ProjectId:       types.StringValue("pid"),
 				SecurityGroupId: types.StringValue("sgid"),
 				Name:            types.StringNull(),
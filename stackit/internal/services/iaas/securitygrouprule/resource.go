@@ -47,6 +47,7 @@ var (
 
 type Model struct {
 	Id                    types.String `tfsdk:"id"` // needed by TF
+	ImportId              types.String `tfsdk:"import_id"`
 	ProjectId             types.String `tfsdk:"project_id"`
 	SecurityGroupId       types.String `tfsdk:"security_group_id"`
 	SecurityGroupRuleId   types.String `tfsdk:"security_group_rule_id"`
@@ -133,12 +134,12 @@ func (r *securityGroupRuleResource) Configure(ctx context.Context, req resource.
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -215,6 +216,13 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the security group rule is associated.",
 				Required:    true,
@@ -464,7 +472,7 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 	// Create new security group rule
 	securityGroupRule, err := r.client.CreateSecurityGroupRule(ctx, projectId, securityGroupId).CreateSecurityGroupRulePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating security group rule", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating security group rule", err, req.Plan)
 		return
 	}
 
@@ -610,6 +618,7 @@ func mapFields(securityGroupRuleResp *iaas.SecurityGroupRule, model *Model) erro
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	model.SecurityGroupRuleId = types.StringValue(securityGroupRuleId)
 	model.Direction = types.StringPointerValue(securityGroupRuleResp.Direction)
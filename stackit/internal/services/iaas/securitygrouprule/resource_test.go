@@ -71,6 +71,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                    types.StringValue("pid,sgid,sgrid"),
+				ImportId:              types.StringValue("pid,sgid,sgrid"),
 				ProjectId:             types.StringValue("pid"),
 				SecurityGroupId:       types.StringValue("sgid"),
 				SecurityGroupRuleId:   types.StringValue("sgrid"),
@@ -105,6 +106,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                    types.StringValue("pid,sgid,sgrid"),
+				ImportId:              types.StringValue("pid,sgid,sgrid"),
 				ProjectId:             types.StringValue("pid"),
 				SecurityGroupId:       types.StringValue("sgid"),
 				SecurityGroupRuleId:   types.StringValue("sgrid"),
@@ -136,6 +138,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                    types.StringValue("pid,sgid,sgrid"),
+				ImportId:              types.StringValue("pid,sgid,sgrid"),
 				ProjectId:             types.StringValue("pid"),
 				SecurityGroupId:       types.StringValue("sgid"),
 				SecurityGroupRuleId:   types.StringValue("sgrid"),
@@ -167,6 +170,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                    types.StringValue("pid,sgid,sgrid"),
+				ImportId:              types.StringValue("pid,sgid,sgrid"),
 				ProjectId:             types.StringValue("pid"),
 				SecurityGroupId:       types.StringValue("sgid"),
 				SecurityGroupRuleId:   types.StringValue("sgrid"),
@@ -7,9 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -30,7 +33,8 @@ var serverDataSourceBetaCheckDone bool
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &serverDataSource{}
+	_ datasource.DataSource                     = &serverDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &serverDataSource{}
 )
 
 type DataSourceModel struct {
@@ -50,6 +54,21 @@ type DataSourceModel struct {
 	CreatedAt         types.String `tfsdk:"created_at"`
 	LaunchedAt        types.String `tfsdk:"launched_at"`
 	UpdatedAt         types.String `tfsdk:"updated_at"`
+	Status            types.String `tfsdk:"status"`
+	PowerStatus       types.String `tfsdk:"power_status"`
+	Nics              types.List   `tfsdk:"nics"`
+	Volumes           types.List   `tfsdk:"volumes"`
+}
+
+// Types corresponding to the elements of DataSourceModel.Nics
+var nicTypes = map[string]attr.Type{
+	"nic_id":       basetypes.StringType{},
+	"network_id":   basetypes.StringType{},
+	"network_name": basetypes.StringType{},
+	"ipv4":         basetypes.StringType{},
+	"ipv6":         basetypes.StringType{},
+	"public_ip":    basetypes.StringType{},
+	"mac":          basetypes.StringType{},
 }
 
 // NewServerDataSource is a helper function to simplify the provider implementation.
@@ -91,12 +110,12 @@ func (d *serverDataSource) Configure(ctx context.Context, req datasource.Configu
 	}
 
 	if providerData.IaaSCustomEndpoint != "" {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -129,15 +148,17 @@ func (r *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				},
 			},
 			"server_id": schema.StringAttribute{
-				Description: "The server ID.",
-				Required:    true,
+				Description: "The server ID. Either `server_id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the server.",
+				Description: "The name of the server. Either `server_id` or `name` must be set. If `name` is set, it must match exactly one server of the project.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"machine_type": schema.StringAttribute{
@@ -187,6 +208,55 @@ func (r *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "The name of the keypair used during server creation.",
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the server, e.g. `ACTIVE`.",
+				Computed:    true,
+			},
+			"power_status": schema.StringAttribute{
+				Description: "The power status of the server, e.g. `RUNNING`.",
+				Computed:    true,
+			},
+			"nics": schema.ListNestedAttribute{
+				Description: "The network interfaces attached to the server.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nic_id": schema.StringAttribute{
+							Description: "The ID of the network interface.",
+							Computed:    true,
+						},
+						"network_id": schema.StringAttribute{
+							Description: "The ID of the network the network interface is attached to.",
+							Computed:    true,
+						},
+						"network_name": schema.StringAttribute{
+							Description: "The name of the network the network interface is attached to.",
+							Computed:    true,
+						},
+						"ipv4": schema.StringAttribute{
+							Description: "The IPv4 address of the network interface.",
+							Computed:    true,
+						},
+						"ipv6": schema.StringAttribute{
+							Description: "The IPv6 address of the network interface.",
+							Computed:    true,
+						},
+						"public_ip": schema.StringAttribute{
+							Description: "The public IP address associated with the network interface.",
+							Computed:    true,
+						},
+						"mac": schema.StringAttribute{
+							Description: "The MAC address of the network interface.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"volumes": schema.ListAttribute{
+				Description: "The IDs of the volumes attached to the server.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
@@ -216,6 +286,16 @@ func (r *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	}
 }
 
+// ConfigValidators validates the data source configuration
+func (r *serverDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("server_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // // Read refreshes the Terraform state with the latest data.
 func (r *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
 	var model DataSourceModel
@@ -225,25 +305,44 @@ func (r *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 	projectId := model.ProjectId.ValueString()
-	serverId := model.ServerId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
-	ctx = tflog.SetField(ctx, "server_id", serverId)
 
-	serverReq := r.client.GetServer(ctx, projectId, serverId)
-	serverReq = serverReq.Details(true)
-	serverResp, err := serverReq.Execute()
-	if err != nil {
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
-		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+	var serverResp *iaas.Server
+	if model.ServerId.IsNull() {
+		name := model.Name.ValueString()
+		ctx = tflog.SetField(ctx, "name", name)
+
+		serversResp, err := r.client.ListServers(ctx, projectId).Details(true).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Calling API to list servers: %v", err))
+			return
+		}
+		serverResp, err = serverByName(serversResp, name)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", err.Error())
+			return
+		}
+	} else {
+		serverId := model.ServerId.ValueString()
+		ctx = tflog.SetField(ctx, "server_id", serverId)
+
+		serverReq := r.client.GetServer(ctx, projectId, serverId)
+		serverReq = serverReq.Details(true)
+		var err error
+		serverResp, err = serverReq.Execute()
+		if err != nil {
+			oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+			if ok && oapiErr.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Calling API: %v", err))
 			return
 		}
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Calling API: %v", err))
-		return
 	}
 
 	// Map response body to schema
-	err = mapDataSourceFields(ctx, serverResp, &model)
+	err := mapDataSourceFields(ctx, serverResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -257,6 +356,15 @@ func (r *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	tflog.Info(ctx, "server read")
 }
 
+// serverByName returns the only server of the list with the given name.
+// Server names are not unique, so an error is returned if none or several servers have the name.
+func serverByName(serversResp *iaas.ServerListResponse, name string) (*iaas.Server, error) {
+	if serversResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	return utils.FindOneByName(serversResp.Items, func(s *iaas.Server) *string { return s.Name }, name, "server", "server_id")
+}
+
 func mapDataSourceFields(ctx context.Context, serverResp *iaas.Server, model *DataSourceModel) error {
 	if serverResp == nil {
 		return fmt.Errorf("response input is nil")
@@ -282,18 +390,9 @@ func mapDataSourceFields(ctx context.Context, serverResp *iaas.Server, model *Da
 		strings.Join(idParts, core.Separator),
 	)
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if serverResp.Labels != nil && len(*serverResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *serverResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, serverResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 	var createdAt basetypes.StringValue
 	if serverResp.CreatedAt != nil {
@@ -325,12 +424,29 @@ func mapDataSourceFields(ctx context.Context, serverResp *iaas.Server, model *Da
 		model.NetworkInterfaces = types.ListNull(types.StringType)
 	}
 
+	nics, err := mapNics(serverResp.Nics)
+	if err != nil {
+		return fmt.Errorf("mapping nics: %w", err)
+	}
+	volumes := types.ListNull(types.StringType)
+	if serverResp.Volumes != nil {
+		var diags diag.Diagnostics
+		volumes, diags = types.ListValueFrom(ctx, types.StringType, *serverResp.Volumes)
+		if diags.HasError() {
+			return fmt.Errorf("mapping volumes: %w", core.DiagsToError(diags))
+		}
+	}
+
 	model.AvailabilityZone = types.StringPointerValue(serverResp.AvailabilityZone)
 	model.ServerId = types.StringValue(serverId)
 	model.MachineType = types.StringPointerValue(serverResp.MachineType)
 
 	model.Name = types.StringPointerValue(serverResp.Name)
 	model.Labels = labels
+	model.Status = types.StringPointerValue(serverResp.Status)
+	model.PowerStatus = types.StringPointerValue(serverResp.PowerStatus)
+	model.Nics = nics
+	model.Volumes = volumes
 	model.ImageId = types.StringPointerValue(serverResp.ImageId)
 	model.KeypairName = types.StringPointerValue(serverResp.KeypairName)
 	model.AffinityGroup = types.StringPointerValue(serverResp.AffinityGroup)
@@ -340,3 +456,33 @@ func mapDataSourceFields(ctx context.Context, serverResp *iaas.Server, model *Da
 
 	return nil
 }
+
+func mapNics(respNics *[]iaas.ServerNetwork) (types.List, error) {
+	nicType := types.ObjectType{AttrTypes: nicTypes}
+	if respNics == nil {
+		return types.ListNull(nicType), nil
+	}
+	nics := []attr.Value{}
+	for i := range *respNics {
+		nic := &(*respNics)[i]
+		nicValues := map[string]attr.Value{
+			"nic_id":       types.StringPointerValue(nic.NicId),
+			"network_id":   types.StringPointerValue(nic.NetworkId),
+			"network_name": types.StringPointerValue(nic.NetworkName),
+			"ipv4":         types.StringPointerValue(nic.Ipv4),
+			"ipv6":         types.StringPointerValue(nic.Ipv6),
+			"public_ip":    types.StringPointerValue(nic.PublicIp),
+			"mac":          types.StringPointerValue(nic.Mac),
+		}
+		nicObject, diags := types.ObjectValue(nicTypes, nicValues)
+		if diags.HasError() {
+			return types.ListNull(nicType), fmt.Errorf("mapping nic %d: %w", i, core.DiagsToError(diags))
+		}
+		nics = append(nics, nicObject)
+	}
+	nicsList, diags := types.ListValue(nicType, nics)
+	if diags.HasError() {
+		return types.ListNull(nicType), core.DiagsToError(diags)
+	}
+	return nicsList, nil
+}
@@ -43,6 +43,9 @@ func TestMapDataSourceFields(t *testing.T) {
 				CreatedAt:         types.StringNull(),
 				UpdatedAt:         types.StringNull(),
 				LaunchedAt:        types.StringNull(),
+				PowerStatus:       types.StringNull(),
+				Nics:              types.ListNull(types.ObjectType{AttrTypes: nicTypes}),
+				Volumes:           types.ListNull(types.StringType),
 			},
 			true,
 		},
@@ -62,12 +65,19 @@ func TestMapDataSourceFields(t *testing.T) {
 				ImageId: utils.Ptr("image_id"),
 				Nics: &[]iaas.ServerNetwork{
 					{
-						NicId: utils.Ptr("nic1"),
+						NicId:       utils.Ptr("nic1"),
+						NetworkId:   utils.Ptr("nid"),
+						NetworkName: utils.Ptr("network"),
+						Ipv4:        utils.Ptr("10.0.0.2"),
+						PublicIp:    utils.Ptr("1.2.3.4"),
+						Mac:         utils.Ptr("fa:16:3e:00:00:01"),
 					},
 					{
 						NicId: utils.Ptr("nic2"),
 					},
 				},
+				Volumes:       &[]string{"vid1", "vid2"},
+				PowerStatus:   utils.Ptr("RUNNING"),
 				KeypairName:   utils.Ptr("keypair_name"),
 				AffinityGroup: utils.Ptr("group_id"),
 				CreatedAt:     utils.Ptr(testTimestamp()),
@@ -93,7 +103,33 @@ func TestMapDataSourceFields(t *testing.T) {
 				AffinityGroup: types.StringValue("group_id"),
 				CreatedAt:     types.StringValue(testTimestampValue),
 				UpdatedAt:     types.StringValue(testTimestampValue),
+				Status:        types.StringValue("active"),
 				LaunchedAt:    types.StringValue(testTimestampValue),
+				PowerStatus:   types.StringValue("RUNNING"),
+				Nics: types.ListValueMust(types.ObjectType{AttrTypes: nicTypes}, []attr.Value{
+					types.ObjectValueMust(nicTypes, map[string]attr.Value{
+						"nic_id":       types.StringValue("nic1"),
+						"network_id":   types.StringValue("nid"),
+						"network_name": types.StringValue("network"),
+						"ipv4":         types.StringValue("10.0.0.2"),
+						"ipv6":         types.StringNull(),
+						"public_ip":    types.StringValue("1.2.3.4"),
+						"mac":          types.StringValue("fa:16:3e:00:00:01"),
+					}),
+					types.ObjectValueMust(nicTypes, map[string]attr.Value{
+						"nic_id":       types.StringValue("nic2"),
+						"network_id":   types.StringNull(),
+						"network_name": types.StringNull(),
+						"ipv4":         types.StringNull(),
+						"ipv6":         types.StringNull(),
+						"public_ip":    types.StringNull(),
+						"mac":          types.StringNull(),
+					}),
+				}),
+				Volumes: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("vid1"),
+					types.StringValue("vid2"),
+				}),
 			},
 			true,
 		},
@@ -122,6 +158,9 @@ func TestMapDataSourceFields(t *testing.T) {
 				CreatedAt:         types.StringNull(),
 				UpdatedAt:         types.StringNull(),
 				LaunchedAt:        types.StringNull(),
+				PowerStatus:       types.StringNull(),
+				Nics:              types.ListNull(types.ObjectType{AttrTypes: nicTypes}),
+				Volumes:           types.ListNull(types.StringType),
 			},
 			true,
 		},
@@ -160,3 +199,73 @@ func TestMapDataSourceFields(t *testing.T) {
 		})
 	}
 }
+
+func TestServerByName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.ServerListResponse
+		name        string
+		expected    *iaas.Server
+		isValid     bool
+	}{
+		{
+			"single_match",
+			&iaas.ServerListResponse{
+				Items: &[]iaas.Server{
+					{Id: utils.Ptr("sid-1"), Name: utils.Ptr("other")},
+					{Id: utils.Ptr("sid-2"), Name: utils.Ptr("name")},
+				},
+			},
+			"name",
+			&iaas.Server{Id: utils.Ptr("sid-2"), Name: utils.Ptr("name")},
+			true,
+		},
+		{
+			"no_match",
+			&iaas.ServerListResponse{
+				Items: &[]iaas.Server{
+					{Id: utils.Ptr("sid-1"), Name: utils.Ptr("other")},
+				},
+			},
+			"name",
+			nil,
+			false,
+		},
+		{
+			"several_matches",
+			&iaas.ServerListResponse{
+				Items: &[]iaas.Server{
+					{Id: utils.Ptr("sid-1"), Name: utils.Ptr("name")},
+					{Id: utils.Ptr("sid-2"), Name: utils.Ptr("name")},
+				},
+			},
+			"name",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"name",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			server, err := serverByName(tt.input, tt.name)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(server, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
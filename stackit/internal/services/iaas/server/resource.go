@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -47,6 +46,7 @@ var resourceBetaCheckDone bool
 var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
+	_ resource.ResourceWithModifyPlan  = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
 
 	supportedSourceTypes = []string{"volume", "image"}
@@ -61,6 +61,7 @@ const (
 
 type Model struct {
 	Id                types.String `tfsdk:"id"` // needed by TF
+	ImportId          types.String `tfsdk:"import_id"`
 	ProjectId         types.String `tfsdk:"project_id"`
 	ServerId          types.String `tfsdk:"server_id"`
 	MachineType       types.String `tfsdk:"machine_type"`
@@ -77,6 +78,8 @@ type Model struct {
 	LaunchedAt        types.String `tfsdk:"launched_at"`
 	UpdatedAt         types.String `tfsdk:"updated_at"`
 	DesiredStatus     types.String `tfsdk:"desired_status"`
+	Status            types.String `tfsdk:"status"`
+	Timeouts          types.Object `tfsdk:"timeouts"`
 }
 
 // Struct corresponding to Model.BootVolume
@@ -104,7 +107,10 @@ func NewServerResource() resource.Resource {
 
 // serverResource is the resource implementation.
 type serverResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
+	providerData  core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -174,12 +180,12 @@ func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRe
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -191,9 +197,19 @@ func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "iaas client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -207,6 +223,13 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the server is associated.",
 				Required:    true,
@@ -350,10 +373,16 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the server, e.g. `ACTIVE`.",
+				Computed:    true,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 			"affinity_group": schema.StringAttribute{
 				Description: "The affinity group the server is assigned to.",
@@ -403,6 +432,9 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -456,6 +488,12 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
+	createTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutCreate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	// Generate API request body from model
 	payload, err := toCreatePayload(ctx, &model)
 	if err != nil {
@@ -467,12 +505,12 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	server, err := r.client.CreateServer(ctx, projectId).CreateServerPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating server", err, req.Plan)
 		return
 	}
 
 	serverId := *server.Id
-	_, err = wait.CreateServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, utils.WithTimeout(wait.CreateServerWaitHandler(ctx, r.client, projectId, serverId), createTimeout), "server creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("server creation waiting: %v", err))
 		return
@@ -488,6 +526,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Map response body to schema
+	server.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, server.Labels, model.Labels)
 	err = mapFields(ctx, server, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("Processing API payload: %v", err))
@@ -522,7 +561,7 @@ func startServer(ctx context.Context, client serverControlClient, projectId, ser
 	if err := client.StartServerExecute(ctx, projectId, serverId); err != nil {
 		return fmt.Errorf("cannot start server: %w", err)
 	}
-	_, err := wait.StartServerWaitHandler(ctx, client, projectId, serverId).WaitWithContext(ctx)
+	_, err := utils.Wait(ctx, wait.StartServerWaitHandler(ctx, client, projectId, serverId), "server start")
 	if err != nil {
 		return fmt.Errorf("cannot check started server: %w", err)
 	}
@@ -534,7 +573,7 @@ func stopServer(ctx context.Context, client serverControlClient, projectId, serv
 	if err := client.StopServerExecute(ctx, projectId, serverId); err != nil {
 		return fmt.Errorf("cannot stop server: %w", err)
 	}
-	_, err := wait.StopServerWaitHandler(ctx, client, projectId, serverId).WaitWithContext(ctx)
+	_, err := utils.Wait(ctx, wait.StopServerWaitHandler(ctx, client, projectId, serverId), "server stop")
 	if err != nil {
 		return fmt.Errorf("cannot check stopped server: %w", err)
 	}
@@ -546,7 +585,7 @@ func deallocatServer(ctx context.Context, client serverControlClient, projectId,
 	if err := client.DeallocateServerExecute(ctx, projectId, serverId); err != nil {
 		return fmt.Errorf("cannot deallocate server: %w", err)
 	}
-	_, err := wait.DeallocateServerWaitHandler(ctx, client, projectId, serverId).WaitWithContext(ctx)
+	_, err := utils.Wait(ctx, wait.DeallocateServerWaitHandler(ctx, client, projectId, serverId), "server deallocation")
 	if err != nil {
 		return fmt.Errorf("cannot check deallocated server: %w", err)
 	}
@@ -646,6 +685,7 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Map response body to schema
+	serverResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, serverResp.Labels, model.Labels)
 	err = mapFields(ctx, serverResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Processing API payload: %v", err))
@@ -679,15 +719,19 @@ func (r *serverResource) updateServerAttributes(ctx context.Context, model, stat
 	// Update machine type
 	modelMachineType := conversion.StringValueToPointer(model.MachineType)
 	if modelMachineType != nil && updatedServer.MachineType != nil && *modelMachineType != *updatedServer.MachineType {
+		updateTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutUpdate)
+		if err != nil {
+			return nil, fmt.Errorf("Reading timeouts: %w", err)
+		}
 		payload := iaas.ResizeServerPayload{
 			MachineType: modelMachineType,
 		}
-		err := r.client.ResizeServer(ctx, projectId, serverId).ResizeServerPayload(payload).Execute()
+		err = r.client.ResizeServer(ctx, projectId, serverId).ResizeServerPayload(payload).Execute()
 		if err != nil {
 			return nil, fmt.Errorf("Resizing the server, calling API: %w", err)
 		}
 
-		_, err = wait.ResizeServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+		_, err = utils.Wait(ctx, utils.WithTimeout(wait.ResizeServerWaitHandler(ctx, r.client, projectId, serverId), updateTimeout), "server resize")
 		if err != nil {
 			return nil, fmt.Errorf("server resize waiting: %w", err)
 		}
@@ -759,10 +803,11 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	serverReq = serverReq.Details(true)
 	updatedServer, err := serverReq.Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating server", err, req.Plan)
 		return
 	}
 
+	updatedServer.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, updatedServer.Labels, model.Labels)
 	err = mapFields(ctx, updatedServer, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", fmt.Sprintf("Processing API payload: %v", err))
@@ -792,13 +837,19 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "server_id", serverId)
 
+	deleteTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutDelete)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	// Delete existing server
-	err := r.client.DeleteServer(ctx, projectId, serverId).Execute()
+	err = r.client.DeleteServer(ctx, projectId, serverId).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, utils.WithTimeout(wait.DeleteServerWaitHandler(ctx, r.client, projectId, serverId), deleteTimeout), "server deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server", fmt.Sprintf("server deletion waiting: %v", err))
 		return
@@ -854,19 +905,11 @@ func mapFields(ctx context.Context, serverResp *iaas.Server, model *Model) error
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if serverResp.Labels != nil && len(*serverResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *serverResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("convert labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, serverResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 	var createdAt basetypes.StringValue
 	if serverResp.CreatedAt != nil {
@@ -938,6 +981,7 @@ func mapFields(ctx context.Context, serverResp *iaas.Server, model *Model) error
 	}
 	model.Name = types.StringPointerValue(serverResp.Name)
 	model.Labels = labels
+	model.Status = types.StringPointerValue(serverResp.Status)
 	model.ImageId = types.StringPointerValue(serverResp.ImageId)
 	model.KeypairName = types.StringPointerValue(serverResp.KeypairName)
 	model.AffinityGroup = types.StringPointerValue(serverResp.AffinityGroup)
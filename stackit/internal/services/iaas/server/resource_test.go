@@ -44,6 +44,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("pid,sid"),
+				ImportId:          types.StringValue("pid,sid"),
 				ProjectId:         types.StringValue("pid"),
 				ServerId:          types.StringValue("sid"),
 				Name:              types.StringNull(),
@@ -91,6 +92,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,sid"),
+				ImportId:         types.StringValue("pid,sid"),
 				ProjectId:        types.StringValue("pid"),
 				ServerId:         types.StringValue("sid"),
 				Name:             types.StringValue("name"),
@@ -104,6 +106,7 @@ func TestMapFields(t *testing.T) {
 				AffinityGroup:     types.StringValue("group_id"),
 				CreatedAt:         types.StringValue(testTimestampValue),
 				UpdatedAt:         types.StringValue(testTimestampValue),
+				Status:            types.StringValue("active"),
 				LaunchedAt:        types.StringValue(testTimestampValue),
 			},
 			true,
@@ -120,6 +123,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("pid,sid"),
+				ImportId:          types.StringValue("pid,sid"),
 				ProjectId:         types.StringValue("pid"),
 				ServerId:          types.StringValue("sid"),
 				Name:              types.StringNull(),
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// serversDataSourceBetaCheckDone is used to prevent multiple checks for beta resources.
+// This is a workaround for the lack of a global state in the provider and
+// needs to exist because the Configure method is called twice.
+var serversDataSourceBetaCheckDone bool
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &serversDataSource{}
+)
+
+// serversDataSourceModel maps the data source schema data.
+type serversDataSourceModel struct {
+	Id            types.String                 `tfsdk:"id"` // needed by TF
+	ProjectId     types.String                 `tfsdk:"project_id"`
+	LabelSelector types.String                 `tfsdk:"label_selector"`
+	Items         []serversDataSourceItemModel `tfsdk:"items"`
+}
+
+// serversDataSourceItemModel maps server schema data.
+type serversDataSourceItemModel struct {
+	ServerId         types.String `tfsdk:"server_id"`
+	Name             types.String `tfsdk:"name"`
+	MachineType      types.String `tfsdk:"machine_type"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Labels           types.Map    `tfsdk:"labels"`
+	Status           types.String `tfsdk:"status"`
+}
+
+// NewServersDataSource is a helper function to simplify the provider implementation.
+func NewServersDataSource() datasource.DataSource {
+	return &serversDataSource{}
+}
+
+// serversDataSource is the data source implementation.
+type serversDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *serversDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+func (d *serversDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var apiClient *iaas.APIClient
+	var err error
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	if !serversDataSourceBetaCheckDone {
+		features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_servers", "data source")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		serversDataSourceBetaCheckDone = true
+	}
+
+	if providerData.IaaSCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.IaaSCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithRegion(providerData.Region),
+		)
+	}
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *serversDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Servers datasource schema. Lists all servers of a project. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: features.AddBetaDescription(description),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the servers are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"label_selector": schema.StringAttribute{
+				Description: "Filter the servers by labels, e.g. `env=prod`. The filtering is done by the API.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The servers matching the given filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.StringAttribute{
+							Description: "The server ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the server.",
+							Computed:    true,
+						},
+						"machine_type": schema.StringAttribute{
+							Description: "Name of the type of the machine for the server.",
+							Computed:    true,
+						},
+						"availability_zone": schema.StringAttribute{
+							Description: "The availability zone of the server.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model serversDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	request := d.client.ListServers(ctx, projectId)
+	if !model.LabelSelector.IsNull() {
+		request = request.LabelSelector(model.LabelSelector.ValueString())
+	}
+	serversResp, err := request.Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading servers", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapServersDataSourceFields(ctx, serversResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading servers", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Servers read")
+}
+
+func mapServersDataSourceFields(ctx context.Context, serversResp *iaas.ServerListResponse, model *serversDataSourceModel) error {
+	if serversResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	model.Items = []serversDataSourceItemModel{}
+	if serversResp.Items == nil {
+		return nil
+	}
+	for i := range *serversResp.Items {
+		server := &(*serversResp.Items)[i]
+		if server.Id == nil {
+			return fmt.Errorf("server id not present")
+		}
+
+		labels, err := utils.MapLabels(ctx, server.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of server %q: %w", *server.Id, err)
+		}
+
+		model.Items = append(model.Items, serversDataSourceItemModel{
+			ServerId:         types.StringPointerValue(server.Id),
+			Name:             types.StringPointerValue(server.Name),
+			MachineType:      types.StringPointerValue(server.MachineType),
+			AvailabilityZone: types.StringPointerValue(server.AvailabilityZone),
+			Labels:           labels,
+			Status:           types.StringPointerValue(server.Status),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapServersDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.ServerListResponse
+		expected    serversDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_servers",
+			&iaas.ServerListResponse{},
+			serversDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items:     []serversDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&iaas.ServerListResponse{
+				Items: &[]iaas.Server{
+					{
+						Id:               utils.Ptr("sid"),
+						Name:             utils.Ptr("name"),
+						MachineType:      utils.Ptr("g1.1"),
+						AvailabilityZone: utils.Ptr("eu01-1"),
+						Labels: &map[string]interface{}{
+							"key": "value",
+						},
+						Status: utils.Ptr("ACTIVE"),
+					},
+					{
+						Id: utils.Ptr("sid-2"),
+					},
+				},
+			},
+			serversDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items: []serversDataSourceItemModel{
+					{
+						ServerId:         types.StringValue("sid"),
+						Name:             types.StringValue("name"),
+						MachineType:      types.StringValue("g1.1"),
+						AvailabilityZone: types.StringValue("eu01-1"),
+						Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+						Status: types.StringValue("ACTIVE"),
+					},
+					{
+						ServerId:         types.StringValue("sid-2"),
+						Name:             types.StringNull(),
+						MachineType:      types.StringNull(),
+						AvailabilityZone: types.StringNull(),
+						Labels:           types.MapNull(types.StringType),
+						Status:           types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_server_id",
+			&iaas.ServerListResponse{
+				Items: &[]iaas.Server{
+					{
+						Name: utils.Ptr("name"),
+					},
+				},
+			},
+			serversDataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			serversDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &serversDataSourceModel{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapServersDataSourceFields(context.Background(), tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
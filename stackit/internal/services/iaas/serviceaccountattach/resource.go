@@ -36,6 +36,7 @@ var (
 
 type Model struct {
 	Id                  types.String `tfsdk:"id"` // needed by TF
+	ImportId            types.String `tfsdk:"import_id"`
 	ProjectId           types.String `tfsdk:"project_id"`
 	ServerId            types.String `tfsdk:"server_id"`
 	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
@@ -81,12 +82,12 @@ func (r *networkInterfaceAttachResource) Configure(ctx context.Context, req reso
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -114,6 +115,13 @@ func (r *networkInterfaceAttachResource) Schema(_ context.Context, _ resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the service account attachment is associated.",
 				Required:    true,
@@ -179,6 +187,7 @@ func (r *networkInterfaceAttachResource) Create(ctx context.Context, req resourc
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,7 +28,8 @@ var volumeDataSourceBetaCheckDone bool
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &volumeDataSource{}
+	_ datasource.DataSource                     = &volumeDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &volumeDataSource{}
 )
 
 // NewVolumeDataSource is a helper function to simplify the provider implementation.
@@ -68,12 +71,12 @@ func (d *volumeDataSource) Configure(ctx context.Context, req datasource.Configu
 	}
 
 	if providerData.IaaSCustomEndpoint != "" {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -97,6 +100,10 @@ func (r *volumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`volume_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the volume is associated.",
 				Required:    true,
@@ -106,8 +113,9 @@ func (r *volumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				},
 			},
 			"volume_id": schema.StringAttribute{
-				Description: "The volume ID.",
-				Required:    true,
+				Description: "The volume ID. Either `volume_id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
 				Validators: []validator.String{
 					validate.UUID(),
 					validate.NoSeparator(),
@@ -122,7 +130,8 @@ func (r *volumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the volume.",
+				Description: "The name of the volume. Either `volume_id` or `name` must be set. If `name` is set, it must match exactly one volume of the project.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": schema.StringAttribute{
@@ -133,6 +142,10 @@ func (r *volumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "The availability zone of the volume.",
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the volume, e.g. `AVAILABLE`.",
+				Computed:    true,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
@@ -164,6 +177,16 @@ func (r *volumeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	}
 }
 
+// ConfigValidators validates the data source configuration
+func (d *volumeDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("volume_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *volumeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -173,22 +196,41 @@ func (d *volumeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 	projectId := model.ProjectId.ValueString()
-	volumeId := model.VolumeId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
-	ctx = tflog.SetField(ctx, "volume_id", volumeId)
 
-	volumeResp, err := d.client.GetVolume(ctx, projectId, volumeId).Execute()
-	if err != nil {
-		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
-		if ok && oapiErr.StatusCode == http.StatusNotFound {
-			resp.State.RemoveResource(ctx)
+	var volumeResp *iaas.Volume
+	if model.VolumeId.IsNull() {
+		name := model.Name.ValueString()
+		ctx = tflog.SetField(ctx, "name", name)
+
+		volumesResp, err := d.client.ListVolumes(ctx, projectId).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume", fmt.Sprintf("Calling API to list volumes: %v", err))
+			return
+		}
+		volumeResp, err = volumeByName(volumesResp, name)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume", err.Error())
+			return
+		}
+	} else {
+		volumeId := model.VolumeId.ValueString()
+		ctx = tflog.SetField(ctx, "volume_id", volumeId)
+
+		var err error
+		volumeResp, err = d.client.GetVolume(ctx, projectId, volumeId).Execute()
+		if err != nil {
+			oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+			if ok && oapiErr.StatusCode == http.StatusNotFound {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume", fmt.Sprintf("Calling API: %v", err))
 			return
 		}
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume", fmt.Sprintf("Calling API: %v", err))
-		return
 	}
 
-	err = mapFields(ctx, volumeResp, &model)
+	err := mapFields(ctx, volumeResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -200,3 +242,12 @@ func (d *volumeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 	tflog.Info(ctx, "volume read")
 }
+
+// volumeByName returns the only volume of the list with the given name.
+// Volume names are not unique, so an error is returned if none or several volumes have the name.
+func volumeByName(volumesResp *iaas.VolumeListResponse, name string) (*iaas.Volume, error) {
+	if volumesResp == nil {
+		return nil, fmt.Errorf("response input is nil")
+	}
+	return utils.FindOneByName(volumesResp.Items, func(v *iaas.Volume) *string { return v.Name }, name, "volume", "volume_id")
+}
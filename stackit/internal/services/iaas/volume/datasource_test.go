@@ -0,0 +1,87 @@
+package volume
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestVolumeByName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.VolumeListResponse
+		name        string
+		expected    *iaas.Volume
+		isValid     bool
+	}{
+		{
+			"single_match",
+			&iaas.VolumeListResponse{
+				Items: &[]iaas.Volume{
+					{Id: utils.Ptr("vid-1"), Name: utils.Ptr("other")},
+					{Id: utils.Ptr("vid-2"), Name: utils.Ptr("name")},
+					{Id: utils.Ptr("vid-3")},
+				},
+			},
+			"name",
+			&iaas.Volume{Id: utils.Ptr("vid-2"), Name: utils.Ptr("name")},
+			true,
+		},
+		{
+			"no_match",
+			&iaas.VolumeListResponse{
+				Items: &[]iaas.Volume{
+					{Id: utils.Ptr("vid-1"), Name: utils.Ptr("other")},
+				},
+			},
+			"name",
+			nil,
+			false,
+		},
+		{
+			"several_matches",
+			&iaas.VolumeListResponse{
+				Items: &[]iaas.Volume{
+					{Id: utils.Ptr("vid-1"), Name: utils.Ptr("name")},
+					{Id: utils.Ptr("vid-2"), Name: utils.Ptr("name")},
+				},
+			},
+			"name",
+			nil,
+			false,
+		},
+		{
+			"no_volumes",
+			&iaas.VolumeListResponse{},
+			"name",
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"name",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			volume, err := volumeByName(tt.input, tt.name)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(volume, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
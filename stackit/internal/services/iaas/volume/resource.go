@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	sdkWait "github.com/stackitcloud/stackit-sdk-go/core/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
@@ -41,13 +44,20 @@ var resourceBetaCheckDone bool
 var (
 	_ resource.Resource                = &volumeResource{}
 	_ resource.ResourceWithConfigure   = &volumeResource{}
+	_ resource.ResourceWithModifyPlan  = &volumeResource{}
 	_ resource.ResourceWithImportState = &volumeResource{}
 
 	SupportedSourceTypes = []string{"volume", "image", "snapshot", "backup"}
 )
 
+const (
+	backupAvailableStatus = "AVAILABLE"
+	backupErrorStatus     = "ERROR"
+)
+
 type Model struct {
 	Id               types.String `tfsdk:"id"` // needed by TF
+	ImportId         types.String `tfsdk:"import_id"`
 	ProjectId        types.String `tfsdk:"project_id"`
 	VolumeId         types.String `tfsdk:"volume_id"`
 	Name             types.String `tfsdk:"name"`
@@ -58,6 +68,13 @@ type Model struct {
 	Size             types.Int64  `tfsdk:"size"`
 	ServerId         types.String `tfsdk:"server_id"`
 	Source           types.Object `tfsdk:"source"`
+	Status           types.String `tfsdk:"status"`
+}
+
+// ResourceModel extends the Model shared with the data source by the resource-only final_backup attribute
+type ResourceModel struct {
+	Model
+	FinalBackup types.Bool `tfsdk:"final_backup"`
 }
 
 // Struct corresponding to Model.Source
@@ -79,7 +96,10 @@ func NewVolumeResource() resource.Resource {
 
 // volumeResource is the resource implementation.
 type volumeResource struct {
-	client *iaas.APIClient
+	client        *iaas.APIClient
+	defaultLabels map[string]string
+	ignoredLabels []string
+	providerData  core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -122,12 +142,12 @@ func (r *volumeResource) Configure(ctx context.Context, req resource.ConfigureRe
 	var err error
 	if providerData.IaaSCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "iaas_custom_endpoint", providerData.IaaSCustomEndpoint)
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.IaaSCustomEndpoint),
 		)
 	} else {
-		apiClient, err = iaas.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -139,9 +159,19 @@ func (r *volumeResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
+	r.defaultLabels = providerData.DefaultLabels
+	r.ignoredLabels = providerData.IgnoreLabels
 	tflog.Info(ctx, "iaas client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *volumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *volumeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -155,6 +185,13 @@ func (r *volumeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the volume is associated.",
 				Required:    true,
@@ -223,10 +260,16 @@ func (r *volumeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 				Required: true,
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the volume, e.g. `AVAILABLE`.",
+				Computed:    true,
+			},
 			"labels": schema.MapAttribute{
 				Description: "Labels are key-value string pairs which can be attached to a resource container",
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
+				Validators:  validate.Labels(),
 			},
 			"performance_class": schema.StringAttribute{
 				MarkdownDescription: "The performance class of the volume. Possible values are documented in [Service plans BlockStorage](https://docs.stackit.cloud/stackit/en/service-plans-blockstorage-75137974.html#ServiceplansBlockStorage-CurrentlyavailableServicePlans%28performanceclasses%29)",
@@ -274,6 +317,10 @@ func (r *volumeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					},
 				},
 			},
+			"final_backup": schema.BoolAttribute{
+				Description: "If set to `true`, a backup of the volume is created before it is deleted. The ID of the backup is reported when the volume is destroyed. The backup is not managed by Terraform and has to be deleted manually. Defaults to `false`.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -314,7 +361,7 @@ func (v volumeResizeModifier) PlanModifyInt64(ctx context.Context, req planmodif
 // Create creates the resource and sets the initial Terraform state.
 func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -334,7 +381,7 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(ctx, &model, source)
+	payload, err := toCreatePayload(ctx, &model.Model, source)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -344,12 +391,12 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	volume, err := r.client.CreateVolume(ctx, projectId).CreateVolumePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating volume", err, req.Plan)
 		return
 	}
 
 	volumeId := *volume.Id
-	volume, err = wait.CreateVolumeWaitHandler(ctx, r.client, projectId, volumeId).WaitWithContext(ctx)
+	volume, err = utils.Wait(ctx, wait.CreateVolumeWaitHandler(ctx, r.client, projectId, volumeId), "volume creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume", fmt.Sprintf("volume creation waiting: %v", err))
 		return
@@ -358,7 +405,8 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	ctx = tflog.SetField(ctx, "volume_id", volumeId)
 
 	// Map response body to schema
-	err = mapFields(ctx, volume, &model)
+	volume.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, volume.Labels, model.Labels)
+	err = mapFields(ctx, volume, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -374,7 +422,7 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -397,7 +445,8 @@ func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, volumeResp, &model)
+	volumeResp.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, volumeResp.Labels, model.Labels)
+	err = mapFields(ctx, volumeResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -414,7 +463,7 @@ func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -426,7 +475,7 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	ctx = tflog.SetField(ctx, "volume_id", volumeId)
 
 	// Retrieve values from state
-	var stateModel Model
+	var stateModel ResourceModel
 	diags = req.State.Get(ctx, &stateModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -434,7 +483,7 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(ctx, &model, stateModel.Labels)
+	payload, err := toUpdatePayload(ctx, &model.Model, stateModel.Labels)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -442,7 +491,7 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update existing volume
 	updatedVolume, err := r.client.UpdateVolume(ctx, projectId, volumeId).UpdateVolumePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating volume", err, req.Plan)
 		return
 	}
 
@@ -464,7 +513,8 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 			updatedVolume.Size = modelSize
 		}
 	}
-	err = mapFields(ctx, updatedVolume, &model)
+	updatedVolume.Labels = utils.RemoveIgnoredLabels(r.ignoredLabels, updatedVolume.Labels, model.Labels)
+	err = mapFields(ctx, updatedVolume, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -480,7 +530,7 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -492,13 +542,23 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "volume_id", volumeId)
 
+	if model.FinalBackup.ValueBool() {
+		backup, err := createFinalBackup(ctx, r.client, projectId, volumeId)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume", fmt.Sprintf("Creating final backup: %v", err))
+			return
+		}
+		ctx = tflog.SetField(ctx, "backup_id", *backup.Id)
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Final volume backup created", fmt.Sprintf("A backup with ID %q was created for volume %q before its deletion. The backup is not managed by Terraform.", *backup.Id, volumeId))
+	}
+
 	// Delete existing volume
 	err := r.client.DeleteVolume(ctx, projectId, volumeId).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteVolumeWaitHandler(ctx, r.client, projectId, volumeId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteVolumeWaitHandler(ctx, r.client, projectId, volumeId), "volume deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume", fmt.Sprintf("volume deletion waiting: %v", err))
 		return
@@ -530,6 +590,51 @@ func (r *volumeResource) ImportState(ctx context.Context, req resource.ImportSta
 	tflog.Info(ctx, "volume state imported")
 }
 
+// createFinalBackup creates a backup of the given volume and waits until it is available
+func createFinalBackup(ctx context.Context, client *iaas.APIClient, projectId, volumeId string) (*iaas.Backup, error) {
+	payload := toFinalBackupPayload(volumeId)
+	backup, err := client.CreateBackup(ctx, projectId).CreateBackupPayload(*payload).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("calling API: %w", err)
+	}
+	if backup == nil || backup.Id == nil {
+		return nil, fmt.Errorf("API didn't return backup ID")
+	}
+	backupId := *backup.Id
+
+	handler := sdkWait.New(func() (waitFinished bool, response *iaas.Backup, err error) {
+		backup, err := client.GetBackupExecute(ctx, projectId, backupId)
+		if err != nil {
+			return false, backup, err
+		}
+		if backup == nil || backup.Status == nil {
+			return false, backup, fmt.Errorf("the response is not valid: the status is missing")
+		}
+		switch *backup.Status {
+		case backupAvailableStatus:
+			return true, backup, nil
+		case backupErrorStatus:
+			return true, backup, fmt.Errorf("backup with ID %q is in error state", backupId)
+		}
+		return false, backup, nil
+	}).SetTimeout(60 * time.Minute)
+	backup, err = utils.Wait(ctx, handler, "final backup creation")
+	if err != nil {
+		return nil, fmt.Errorf("backup creation waiting: %w", err)
+	}
+	return backup, nil
+}
+
+func toFinalBackupPayload(volumeId string) *iaas.CreateBackupPayload {
+	return &iaas.CreateBackupPayload{
+		Name: sdkUtils.Ptr(fmt.Sprintf("%s-final-backup", volumeId)),
+		Source: &iaas.BackupSource{
+			Id:   sdkUtils.Ptr(volumeId),
+			Type: sdkUtils.Ptr("volume"),
+		},
+	}
+}
+
 func mapFields(ctx context.Context, volumeResp *iaas.Volume, model *Model) error {
 	if volumeResp == nil {
 		return fmt.Errorf("response input is nil")
@@ -554,19 +659,11 @@ func mapFields(ctx context.Context, volumeResp *iaas.Volume, model *Model) error
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
-	labels, diags := types.MapValueFrom(ctx, types.StringType, map[string]interface{}{})
-	if diags.HasError() {
-		return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-	}
-	if volumeResp.Labels != nil && len(*volumeResp.Labels) != 0 {
-		var diags diag.Diagnostics
-		labels, diags = types.MapValueFrom(ctx, types.StringType, *volumeResp.Labels)
-		if diags.HasError() {
-			return fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
-		}
-	} else if model.Labels.IsNull() {
-		labels = types.MapNull(types.StringType)
+	labels, err := utils.MapLabels(ctx, volumeResp.Labels, model.Labels)
+	if err != nil {
+		return fmt.Errorf("mapping labels: %w", err)
 	}
 
 	var sourceValues map[string]attr.Value
@@ -578,6 +675,7 @@ func mapFields(ctx context.Context, volumeResp *iaas.Volume, model *Model) error
 			"type": types.StringPointerValue(volumeResp.Source.Type),
 			"id":   types.StringPointerValue(volumeResp.Source.Id),
 		}
+		var diags diag.Diagnostics
 		sourceObject, diags = types.ObjectValue(sourceTypes, sourceValues)
 		if diags.HasError() {
 			return fmt.Errorf("creating source: %w", core.DiagsToError(diags))
@@ -589,6 +687,7 @@ func mapFields(ctx context.Context, volumeResp *iaas.Volume, model *Model) error
 	model.Description = types.StringPointerValue(volumeResp.Description)
 	model.Name = types.StringPointerValue(volumeResp.Name)
 	model.Labels = labels
+	model.Status = types.StringPointerValue(volumeResp.Status)
 	model.PerformanceClass = types.StringPointerValue(volumeResp.PerformanceClass)
 	model.ServerId = types.StringPointerValue(volumeResp.ServerId)
 	model.Size = types.Int64PointerValue(volumeResp.Size)
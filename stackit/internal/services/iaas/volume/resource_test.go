@@ -30,6 +30,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,nid"),
+				ImportId:         types.StringValue("pid,nid"),
 				ProjectId:        types.StringValue("pid"),
 				VolumeId:         types.StringValue("nid"),
 				Name:             types.StringNull(),
@@ -64,6 +65,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,nid"),
+				ImportId:         types.StringValue("pid,nid"),
 				ProjectId:        types.StringValue("pid"),
 				VolumeId:         types.StringValue("nid"),
 				Name:             types.StringValue("name"),
@@ -94,6 +96,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,nid"),
+				ImportId:         types.StringValue("pid,nid"),
 				ProjectId:        types.StringValue("pid"),
 				VolumeId:         types.StringValue("nid"),
 				Name:             types.StringNull(),
@@ -251,3 +254,18 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestToFinalBackupPayload(t *testing.T) {
+	expected := &iaas.CreateBackupPayload{
+		Name: utils.Ptr("vid-final-backup"),
+		Source: &iaas.BackupSource{
+			Id:   utils.Ptr("vid"),
+			Type: utils.Ptr("volume"),
+		},
+	}
+	output := toFinalBackupPayload("vid")
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
@@ -0,0 +1,252 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// volumesDataSourceBetaCheckDone is used to prevent multiple checks for beta resources.
+// This is a workaround for the lack of a global state in the provider and
+// needs to exist because the Configure method is called twice.
+var volumesDataSourceBetaCheckDone bool
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &volumesDataSource{}
+)
+
+// volumesDataSourceModel maps the data source schema data.
+type volumesDataSourceModel struct {
+	Id            types.String                 `tfsdk:"id"` // needed by TF
+	ProjectId     types.String                 `tfsdk:"project_id"`
+	LabelSelector types.String                 `tfsdk:"label_selector"`
+	Items         []volumesDataSourceItemModel `tfsdk:"items"`
+}
+
+// volumesDataSourceItemModel maps volume schema data.
+type volumesDataSourceItemModel struct {
+	VolumeId         types.String `tfsdk:"volume_id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	PerformanceClass types.String `tfsdk:"performance_class"`
+	Size             types.Int64  `tfsdk:"size"`
+	ServerId         types.String `tfsdk:"server_id"`
+	Labels           types.Map    `tfsdk:"labels"`
+	Status           types.String `tfsdk:"status"`
+}
+
+// NewVolumesDataSource is a helper function to simplify the provider implementation.
+func NewVolumesDataSource() datasource.DataSource {
+	return &volumesDataSource{}
+}
+
+// volumesDataSource is the data source implementation.
+type volumesDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *volumesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volumes"
+}
+
+func (d *volumesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var apiClient *iaas.APIClient
+	var err error
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	if !volumesDataSourceBetaCheckDone {
+		features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_volumes", "data source")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		volumesDataSourceBetaCheckDone = true
+	}
+
+	if providerData.IaaSCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.IaaSCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, iaas.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithRegion(providerData.Region),
+		)
+	}
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *volumesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Volumes datasource schema. Lists all volumes of a project. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: features.AddBetaDescription(description),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the volumes are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"label_selector": schema.StringAttribute{
+				Description: "Filter the volumes by labels, e.g. `env=prod`. The filtering is done by the API.",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The volumes matching the given filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"volume_id": schema.StringAttribute{
+							Description: "The volume ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the volume.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the volume.",
+							Computed:    true,
+						},
+						"availability_zone": schema.StringAttribute{
+							Description: "The availability zone of the volume.",
+							Computed:    true,
+						},
+						"performance_class": schema.StringAttribute{
+							Description: "The performance class of the volume.",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "The size of the volume in GB.",
+							Computed:    true,
+						},
+						"server_id": schema.StringAttribute{
+							Description: "The server ID of the server to which the volume is attached to.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the volume, e.g. `AVAILABLE`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *volumesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model volumesDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	request := d.client.ListVolumes(ctx, projectId)
+	if !model.LabelSelector.IsNull() {
+		request = request.LabelSelector(model.LabelSelector.ValueString())
+	}
+	volumesResp, err := request.Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volumes", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapVolumesDataSourceFields(ctx, volumesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volumes", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Volumes read")
+}
+
+func mapVolumesDataSourceFields(ctx context.Context, volumesResp *iaas.VolumeListResponse, model *volumesDataSourceModel) error {
+	if volumesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	model.Items = []volumesDataSourceItemModel{}
+	if volumesResp.Items == nil {
+		return nil
+	}
+	for i := range *volumesResp.Items {
+		volume := &(*volumesResp.Items)[i]
+		if volume.Id == nil {
+			return fmt.Errorf("volume id not present")
+		}
+
+		labels, err := utils.MapLabels(ctx, volume.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of volume %q: %w", *volume.Id, err)
+		}
+
+		model.Items = append(model.Items, volumesDataSourceItemModel{
+			VolumeId:         types.StringPointerValue(volume.Id),
+			Name:             types.StringPointerValue(volume.Name),
+			Description:      types.StringPointerValue(volume.Description),
+			AvailabilityZone: types.StringPointerValue(volume.AvailabilityZone),
+			PerformanceClass: types.StringPointerValue(volume.PerformanceClass),
+			Size:             types.Int64PointerValue(volume.Size),
+			ServerId:         types.StringPointerValue(volume.ServerId),
+			Labels:           labels,
+			Status:           types.StringPointerValue(volume.Status),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package volume
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapVolumesDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.VolumeListResponse
+		expected    volumesDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_volumes",
+			&iaas.VolumeListResponse{},
+			volumesDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items:     []volumesDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&iaas.VolumeListResponse{
+				Items: &[]iaas.Volume{
+					{
+						Id:               utils.Ptr("vid"),
+						Name:             utils.Ptr("name"),
+						Description:      utils.Ptr("description"),
+						AvailabilityZone: utils.Ptr("eu01-1"),
+						PerformanceClass: utils.Ptr("storage_premium_perf1"),
+						Size:             utils.Ptr(int64(20)),
+						ServerId:         utils.Ptr("sid"),
+						Labels: &map[string]interface{}{
+							"key": "value",
+						},
+						Status: utils.Ptr("ATTACHED"),
+					},
+					{
+						Id: utils.Ptr("vid-2"),
+					},
+				},
+			},
+			volumesDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items: []volumesDataSourceItemModel{
+					{
+						VolumeId:         types.StringValue("vid"),
+						Name:             types.StringValue("name"),
+						Description:      types.StringValue("description"),
+						AvailabilityZone: types.StringValue("eu01-1"),
+						PerformanceClass: types.StringValue("storage_premium_perf1"),
+						Size:             types.Int64Value(20),
+						ServerId:         types.StringValue("sid"),
+						Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+						Status: types.StringValue("ATTACHED"),
+					},
+					{
+						VolumeId:         types.StringValue("vid-2"),
+						Name:             types.StringNull(),
+						Description:      types.StringNull(),
+						AvailabilityZone: types.StringNull(),
+						PerformanceClass: types.StringNull(),
+						Size:             types.Int64Null(),
+						ServerId:         types.StringNull(),
+						Labels:           types.MapNull(types.StringType),
+						Status:           types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_volume_id",
+			&iaas.VolumeListResponse{
+				Items: &[]iaas.Volume{
+					{
+						Name: utils.Ptr("name"),
+					},
+				},
+			},
+			volumesDataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			volumesDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &volumesDataSourceModel{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapVolumesDataSourceFields(context.Background(), tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
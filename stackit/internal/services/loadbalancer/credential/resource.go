@@ -32,6 +32,7 @@ var (
 
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
+	ImportId       types.String `tfsdk:"import_id"`
 	ProjectId      types.String `tfsdk:"project_id"`
 	DisplayName    types.String `tfsdk:"display_name"`
 	Username       types.String `tfsdk:"username"`
@@ -71,12 +72,12 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 	var err error
 	if providerData.LoadBalancerCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "loadbalancer_custom_endpoint", providerData.LoadBalancerCustomEndpoint)
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.LoadBalancerCustomEndpoint),
 		)
 	} else {
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -96,6 +97,7 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	descriptions := map[string]string{
 		"main":            "Load balancer credential resource schema. Must have a `region` specified in the provider configuration.",
 		"id":              "Terraform's internal resource ID. It is structured as \"`project_id`\",\"`credentials_ref`\".",
+		"import_id":       "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"credentials_ref": "The credentials reference can be used for observability of the Load Balancer.",
 		"project_id":      "STACKIT project ID to which the load balancer credential is associated.",
 		"display_name":    "Credential name.",
@@ -118,6 +120,13 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"credentials_ref": schema.StringAttribute{
 				Description: descriptions["credentials_ref"],
 				Computed:    true,
@@ -182,7 +191,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create new credentials
 	createResp, err := r.client.CreateCredentials(ctx, projectId).CreateCredentialsPayload(*payload).XRequestID(uuid.NewString()).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating credential", err, req.Plan)
 		return
 	}
 	ctx = tflog.SetField(ctx, "credentials_ref", createResp.Credential.CredentialsRef)
@@ -338,6 +347,7 @@ func mapFields(cred *loadbalancer.CredentialsResponse, m *Model) error {
 	m.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	m.ImportId = m.Id
 
 	return nil
 }
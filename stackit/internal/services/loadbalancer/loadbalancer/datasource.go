@@ -57,12 +57,12 @@ func (r *loadBalancerDataSource) Configure(ctx context.Context, req datasource.C
 	var apiClient *loadbalancer.APIClient
 	var err error
 	if providerData.LoadBalancerCustomEndpoint != "" {
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.LoadBalancerCustomEndpoint),
 		)
 	} else {
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -82,11 +82,12 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	descriptions := map[string]string{
 		"main":                        "Load Balancer data source schema. Must have a `region` specified in the provider configuration.",
 		"id":                          "Terraform's internal resource ID. It is structured as \"`project_id`\",\"`name`\".",
+		"import_id":                   "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"project_id":                  "STACKIT project ID to which the Load Balancer is associated.",
 		"external_address":            "External Load Balancer IP address where this Load Balancer is exposed.",
 		"listeners":                   "List of all listeners which will accept traffic. Limited to 20.",
 		"port":                        "Port number where we listen for traffic.",
-		"protocol":                    "Protocol is the highest network protocol we understand to load balance.",
+		"protocol":                    "Protocol is the highest network protocol we understand to load balance. With `PROTOCOL_TCP_PROXY`, the Load Balancer sends a PROXY protocol v2 header at the start of each connection, so that the targets receive the original client IP. The targets must accept the PROXY protocol in this case.",
 		"target_pool":                 "Reference target pool by target pool name.",
 		"name":                        "Load balancer name.",
 		"networks":                    "List of networks that listeners and targets reside in.",
@@ -99,7 +100,11 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		"use_source_ip_address":       "If true then all connections from one source IP address are redirected to the same target. This setting changes the load balancing algorithm to Maglev.",
 		"server_name_indicators":      "A list of domain names to match in order to pass TLS traffic to the target pool in the current listener",
 		"server_name_indicators.name": "A domain name to match in order to pass TLS traffic to the target pool in the current listener",
+		"tcp":                         "Options specific to listeners with a TCP based protocol.",
+		"udp":                         "Options specific to listeners with the `PROTOCOL_UDP` protocol.",
+		"idle_timeout":                "Time after which an idle connection is closed.",
 		"private_address":             "Transient private Load Balancer IP address. It can change any time.",
+		"status":                      "Status of the Load Balancer, e.g. `STATUS_READY`.",
 		"target_pools":                "List of all target pools which will be used in the Load Balancer. Limited to 20.",
 		"healthy_threshold":           "Healthy threshold of the health checking.",
 		"interval":                    "Interval duration of health checking in seconds.",
@@ -120,6 +125,10 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: descriptions["project_id"],
 				Required:    true,
@@ -167,6 +176,26 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							Description: descriptions["target_pool"],
 							Computed:    true,
 						},
+						"tcp": schema.SingleNestedAttribute{
+							Description: descriptions["tcp"],
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"idle_timeout": schema.StringAttribute{
+									Description: descriptions["idle_timeout"],
+									Computed:    true,
+								},
+							},
+						},
+						"udp": schema.SingleNestedAttribute{
+							Description: descriptions["udp"],
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"idle_timeout": schema.StringAttribute{
+									Description: descriptions["idle_timeout"],
+									Computed:    true,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -226,6 +255,10 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: descriptions["private_address"],
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"target_pools": schema.ListNestedAttribute{
 				Description: descriptions["target_pools"],
 				Computed:    true,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -39,13 +41,16 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &loadBalancerResource{}
-	_ resource.ResourceWithConfigure   = &loadBalancerResource{}
-	_ resource.ResourceWithImportState = &loadBalancerResource{}
+	_ resource.Resource                     = &loadBalancerResource{}
+	_ resource.ResourceWithConfigure        = &loadBalancerResource{}
+	_ resource.ResourceWithModifyPlan       = &loadBalancerResource{}
+	_ resource.ResourceWithImportState      = &loadBalancerResource{}
+	_ resource.ResourceWithConfigValidators = &loadBalancerResource{}
 )
 
 type Model struct {
 	Id              types.String `tfsdk:"id"` // needed by TF
+	ImportId        types.String `tfsdk:"import_id"`
 	ProjectId       types.String `tfsdk:"project_id"`
 	ExternalAddress types.String `tfsdk:"external_address"`
 	Listeners       types.List   `tfsdk:"listeners"`
@@ -54,6 +59,13 @@ type Model struct {
 	Options         types.Object `tfsdk:"options"`
 	PrivateAddress  types.String `tfsdk:"private_address"`
 	TargetPools     types.List   `tfsdk:"target_pools"`
+	Status          types.String `tfsdk:"status"`
+}
+
+// ResourceModel extends the Model shared with the data source by the resource-only timeouts block
+type ResourceModel struct {
+	Model
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 // Struct corresponding to Model.Listeners[i]
@@ -63,6 +75,8 @@ type listener struct {
 	Protocol             types.String `tfsdk:"protocol"`
 	ServerNameIndicators types.List   `tfsdk:"server_name_indicators"`
 	TargetPool           types.String `tfsdk:"target_pool"`
+	TCP                  types.Object `tfsdk:"tcp"`
+	UDP                  types.Object `tfsdk:"udp"`
 }
 
 // Types corresponding to listener
@@ -72,6 +86,18 @@ var listenerTypes = map[string]attr.Type{
 	"protocol":               types.StringType,
 	"server_name_indicators": types.ListType{ElemType: types.ObjectType{AttrTypes: serverNameIndicatorTypes}},
 	"target_pool":            types.StringType,
+	"tcp":                    types.ObjectType{AttrTypes: protocolOptionsTypes},
+	"udp":                    types.ObjectType{AttrTypes: protocolOptionsTypes},
+}
+
+// Struct corresponding to listener.TCP and listener.UDP
+type protocolOptions struct {
+	IdleTimeout types.String `tfsdk:"idle_timeout"`
+}
+
+// Types corresponding to protocolOptions
+var protocolOptionsTypes = map[string]attr.Type{
+	"idle_timeout": types.StringType,
 }
 
 // Struct corresponding to listener.ServerNameIndicators[i]
@@ -173,7 +199,8 @@ func NewLoadBalancerResource() resource.Resource {
 
 // loadBalancerResource is the resource implementation.
 type loadBalancerResource struct {
-	client *loadbalancer.APIClient
+	client       *loadbalancer.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -198,12 +225,12 @@ func (r *loadBalancerResource) Configure(ctx context.Context, req resource.Confi
 	var err error
 	if providerData.LoadBalancerCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "loadbalancer_custom_endpoint", providerData.LoadBalancerCustomEndpoint)
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.LoadBalancerCustomEndpoint),
 		)
 	} else {
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -215,23 +242,31 @@ func (r *loadBalancerResource) Configure(ctx context.Context, req resource.Confi
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "Load Balancer client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *loadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	protocolOptions := []string{"PROTOCOL_UNSPECIFIED", "PROTOCOL_TCP", "PROTOCOL_UDP", "PROTOCOL_TCP_PROXY", "PROTOCOL_TLS_PASSTHROUGH"}
+	listenerProtocolOptions := []string{"PROTOCOL_UNSPECIFIED", "PROTOCOL_TCP", "PROTOCOL_UDP", "PROTOCOL_TCP_PROXY", "PROTOCOL_TLS_PASSTHROUGH"}
 	roleOptions := []string{"ROLE_UNSPECIFIED", "ROLE_LISTENERS_AND_TARGETS", "ROLE_LISTENERS", "ROLE_TARGETS"}
 
 	descriptions := map[string]string{
 		"main":                        "Load Balancer resource schema.",
 		"id":                          "Terraform's internal resource ID. It is structured as \"`project_id`\",\"`name`\".",
+		"import_id":                   "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"project_id":                  "STACKIT project ID to which the Load Balancer is associated.",
 		"external_address":            "External Load Balancer IP address where this Load Balancer is exposed.",
 		"listeners":                   "List of all listeners which will accept traffic. Limited to 20.",
 		"port":                        "Port number where we listen for traffic.",
-		"protocol":                    "Protocol is the highest network protocol we understand to load balance. " + utils.SupportedValuesDocumentation(protocolOptions),
-		"target_pool":                 "Reference target pool by target pool name.",
+		"protocol":                    "Protocol is the highest network protocol we understand to load balance. " + utils.SupportedValuesDocumentation(listenerProtocolOptions) + " With `PROTOCOL_TCP_PROXY`, the Load Balancer sends a PROXY protocol v2 header at the start of each connection, so that the targets receive the original client IP. The targets must accept the PROXY protocol in this case.",
+		"target_pool":                 "Reference target pool by target pool name. Must match the name of one of the `target_pools`.",
 		"name":                        "Load balancer name.",
 		"networks":                    "List of networks that listeners and targets reside in.",
 		"network_id":                  "Openstack network ID.",
@@ -243,7 +278,11 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 		"use_source_ip_address":       "If true then all connections from one source IP address are redirected to the same target. This setting changes the load balancing algorithm to Maglev.",
 		"server_name_indicators":      "A list of domain names to match in order to pass TLS traffic to the target pool in the current listener",
 		"server_name_indicators.name": "A domain name to match in order to pass TLS traffic to the target pool in the current listener",
+		"tcp":                         "Options specific to listeners with a TCP based protocol (`PROTOCOL_TCP`, `PROTOCOL_TCP_PROXY` or `PROTOCOL_TLS_PASSTHROUGH`).",
+		"udp":                         "Options specific to listeners with the `PROTOCOL_UDP` protocol.",
+		"idle_timeout":                "Time after which an idle connection is closed, e.g. `300s`.",
 		"private_address":             "Transient private Load Balancer IP address. It can change any time.",
+		"status":                      "Status of the Load Balancer, e.g. `STATUS_READY`.",
 		"target_pools":                "List of all target pools which will be used in the Load Balancer. Limited to 20.",
 		"healthy_threshold":           "Healthy threshold of the health checking.",
 		"interval":                    "Interval duration of health checking in seconds.",
@@ -272,6 +311,13 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: descriptions["project_id"],
 				Required:    true,
@@ -327,7 +373,7 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 								stringplanmodifier.UseStateForUnknown(),
 							},
 							Validators: []validator.String{
-								stringvalidator.OneOf(protocolOptions...),
+								stringvalidator.OneOf(listenerProtocolOptions...),
 							},
 						},
 						"server_name_indicators": schema.ListNestedAttribute{
@@ -351,6 +397,50 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
+						"tcp": schema.SingleNestedAttribute{
+							Description: descriptions["tcp"],
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Object{
+								objectplanmodifier.RequiresReplace(),
+								objectplanmodifier.UseStateForUnknown(),
+							},
+							Attributes: map[string]schema.Attribute{
+								"idle_timeout": schema.StringAttribute{
+									Description: descriptions["idle_timeout"],
+									Optional:    true,
+									Computed:    true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(
+											regexp.MustCompile(`^[0-9]+(\.[0-9]+)?s$`),
+											"must be a duration in seconds, e.g. `300s`",
+										),
+									},
+								},
+							},
+						},
+						"udp": schema.SingleNestedAttribute{
+							Description: descriptions["udp"],
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Object{
+								objectplanmodifier.RequiresReplace(),
+								objectplanmodifier.UseStateForUnknown(),
+							},
+							Attributes: map[string]schema.Attribute{
+								"idle_timeout": schema.StringAttribute{
+									Description: descriptions["idle_timeout"],
+									Optional:    true,
+									Computed:    true,
+									Validators: []validator.String{
+										stringvalidator.RegexMatches(
+											regexp.MustCompile(`^[0-9]+(\.[0-9]+)?s$`),
+											"must be a duration in seconds, e.g. `300s`",
+										),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -445,6 +535,10 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"target_pools": schema.ListNestedAttribute{
 				Description: descriptions["target_pools"],
 				Required:    true,
@@ -528,13 +622,125 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(),
+		},
+	}
+}
+
+// ConfigValidators validates the resource configuration
+func (r *loadBalancerResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validate.NewResourceValidator(
+			"the `tcp` and `udp` options of a listener must match its protocol",
+			func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+				var model ResourceModel
+				resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(checkListenerProtocolOptions(ctx, &model.Model)...)
+			},
+		),
+		validate.NewResourceValidator(
+			"every listener must reference a target pool defined in `target_pools`",
+			func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+				var model ResourceModel
+				resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(checkListenerTargetPools(ctx, &model.Model)...)
+			},
+		),
+	}
+}
+
+// checkListenerProtocolOptions checks that the `tcp` options are only set for listeners with a TCP based protocol
+// and the `udp` options are only set for listeners with the UDP protocol.
+// Listeners whose protocol is not yet known are skipped.
+func checkListenerProtocolOptions(ctx context.Context, model *Model) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if utils.IsUndefined(model.Listeners) {
+		return diags
+	}
+
+	listenersModel := []listener{}
+	diags.Append(model.Listeners.ElementsAs(ctx, &listenersModel, false)...)
+	if diags.HasError() {
+		return diags
 	}
+
+	for i := range listenersModel {
+		l := &listenersModel[i]
+		if utils.IsUndefined(l.Protocol) {
+			continue
+		}
+		protocol := l.Protocol.ValueString()
+		isUDP := protocol == "PROTOCOL_UDP"
+		if !utils.IsUndefined(l.TCP) && isUDP {
+			diags.AddAttributeError(
+				path.Root("listeners").AtListIndex(i).AtName("tcp"),
+				"Invalid listener configuration",
+				fmt.Sprintf("The `tcp` options can't be set for a listener with the protocol %q.", protocol),
+			)
+		}
+		if !utils.IsUndefined(l.UDP) && !isUDP {
+			diags.AddAttributeError(
+				path.Root("listeners").AtListIndex(i).AtName("udp"),
+				"Invalid listener configuration",
+				fmt.Sprintf("The `udp` options can only be set for a listener with the protocol \"PROTOCOL_UDP\", got %q.", protocol),
+			)
+		}
+	}
+	return diags
+}
+
+// checkListenerTargetPools checks that the target pool referenced by each listener is part of the configured target pools.
+// Listeners or target pools whose values are not yet known are skipped.
+func checkListenerTargetPools(ctx context.Context, model *Model) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if utils.IsUndefined(model.Listeners) || utils.IsUndefined(model.TargetPools) {
+		return diags
+	}
+
+	targetPoolsModel := []targetPool{}
+	diags.Append(model.TargetPools.ElementsAs(ctx, &targetPoolsModel, false)...)
+	listenersModel := []listener{}
+	diags.Append(model.Listeners.ElementsAs(ctx, &listenersModel, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	targetPoolNames := map[string]bool{}
+	for i := range targetPoolsModel {
+		if targetPoolsModel[i].Name.IsUnknown() {
+			// A target pool name is computed from an unknown value, so it can't be checked yet
+			return diags
+		}
+		targetPoolNames[targetPoolsModel[i].Name.ValueString()] = true
+	}
+
+	for i := range listenersModel {
+		listenerTargetPool := listenersModel[i].TargetPool
+		if utils.IsUndefined(listenerTargetPool) {
+			continue
+		}
+		if !targetPoolNames[listenerTargetPool.ValueString()] {
+			diags.AddAttributeError(
+				path.Root("listeners").AtListIndex(i).AtName("target_pool"),
+				"Invalid listener configuration",
+				fmt.Sprintf("The listener references the target pool %q, which is not defined in `target_pools`.", listenerTargetPool.ValueString()),
+			)
+		}
+	}
+	return diags
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -543,8 +749,14 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
+	createTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutCreate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	// Generate API request body from model
-	payload, err := toCreatePayload(ctx, &model)
+	payload, err := toCreatePayload(ctx, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -553,18 +765,18 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 	// Create a new load balancer
 	createResp, err := r.client.CreateLoadBalancer(ctx, projectId).CreateLoadBalancerPayload(*payload).XRequestID(uuid.NewString()).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating load balancer", err, req.Plan)
 		return
 	}
 
-	waitResp, err := wait.CreateLoadBalancerWaitHandler(ctx, r.client, projectId, *createResp.Name).SetTimeout(90 * time.Minute).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, utils.WithTimeout(wait.CreateLoadBalancerWaitHandler(ctx, r.client, projectId, *createResp.Name).SetTimeout(90*time.Minute), createTimeout), "load balancer creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Load balancer creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model)
+	err = mapFields(ctx, waitResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -582,7 +794,7 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read refreshes the Terraform state with the latest data.
 func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -605,11 +817,12 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, lbResp, &model)
+	err = mapFields(ctx, lbResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading load balancer", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	checkLoadBalancerStatus(ctx, lbResp, &resp.Diagnostics)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -620,10 +833,34 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Info(ctx, "Load balancer read")
 }
 
+// checkLoadBalancerStatus adds a warning if the load balancer is in a state which needs user attention,
+// so that the plan is not blocked but the user is informed about how to proceed.
+func checkLoadBalancerStatus(ctx context.Context, lb *loadbalancer.LoadBalancer, diags *diag.Diagnostics) {
+	if lb == nil || lb.Status == nil {
+		return
+	}
+	switch *lb.Status {
+	case wait.InstanceStatusTerminating:
+		core.LogAndAddWarning(ctx, diags, "Load balancer is being deleted",
+			"The load balancer is currently being deleted. Wait until the deletion has finished before running the next apply, the load balancer will then be removed from the state and recreated if it is still part of the configuration.")
+	case wait.InstanceStatusError:
+		errs := []string{}
+		if lb.Errors != nil {
+			for _, e := range *lb.Errors {
+				if e.Description != nil {
+					errs = append(errs, *e.Description)
+				}
+			}
+		}
+		core.LogAndAddWarning(ctx, diags, "Load balancer is in an error state",
+			fmt.Sprintf("The load balancer reported errors: [%s]. Fix the reported issues or recreate the load balancer with `terraform apply -replace` (or `terraform taint`).", strings.Join(errs, ", ")))
+	}
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -669,7 +906,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, getResp, &model)
+	err = mapFields(ctx, getResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -687,7 +924,7 @@ func (r *loadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -698,14 +935,20 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "name", name)
 
+	deleteTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutDelete)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting load balancer", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	// Delete load balancer
-	_, err := r.client.DeleteLoadBalancer(ctx, projectId, name).Execute()
+	_, err = r.client.DeleteLoadBalancer(ctx, projectId, name).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting load balancer", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
 
-	_, err = wait.DeleteLoadBalancerWaitHandler(ctx, r.client, projectId, name).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, utils.WithTimeout(wait.DeleteLoadBalancerWaitHandler(ctx, r.client, projectId, name), deleteTimeout), "load balancer deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting load balancer", fmt.Sprintf("Load balancer deleting waiting: %v", err))
 		return
@@ -786,13 +1029,34 @@ func toListenersPayload(ctx context.Context, model *Model) (*[]loadbalancer.List
 		if err != nil {
 			return nil, fmt.Errorf("converting index %d: converting server_name_indicator: %w", i, err)
 		}
-		payload = append(payload, loadbalancer.Listener{
+		listenerPayload := loadbalancer.Listener{
 			DisplayName:          conversion.StringValueToPointer(listenerModel.DisplayName),
 			Port:                 conversion.Int64ValueToPointer(listenerModel.Port),
 			Protocol:             conversion.StringValueToPointer(listenerModel.Protocol),
 			ServerNameIndicators: serverNameIndicatorsPayload,
 			TargetPool:           conversion.StringValueToPointer(listenerModel.TargetPool),
-		})
+		}
+		if !utils.IsUndefined(listenerModel.TCP) {
+			tcpModel := protocolOptions{}
+			diags := listenerModel.TCP.As(ctx, &tcpModel, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, fmt.Errorf("converting index %d: converting tcp: %w", i, core.DiagsToError(diags))
+			}
+			listenerPayload.Tcp = &loadbalancer.OptionsTCP{
+				IdleTimeout: conversion.StringValueToPointer(tcpModel.IdleTimeout),
+			}
+		}
+		if !utils.IsUndefined(listenerModel.UDP) {
+			udpModel := protocolOptions{}
+			diags := listenerModel.UDP.As(ctx, &udpModel, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, fmt.Errorf("converting index %d: converting udp: %w", i, core.DiagsToError(diags))
+			}
+			listenerPayload.Udp = &loadbalancer.OptionsUDP{
+				IdleTimeout: conversion.StringValueToPointer(udpModel.IdleTimeout),
+			}
+		}
+		payload = append(payload, listenerPayload)
 	}
 
 	return &payload, nil
@@ -1036,9 +1300,11 @@ func mapFields(ctx context.Context, lb *loadbalancer.LoadBalancer, m *Model) err
 	m.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	m.ImportId = m.Id
 
 	m.ExternalAddress = types.StringPointerValue(lb.ExternalAddress)
 	m.PrivateAddress = types.StringPointerValue(lb.PrivateAddress)
+	m.Status = types.StringPointerValue(lb.Status)
 
 	err := mapListeners(lb, m)
 	if err != nil {
@@ -1080,6 +1346,21 @@ func mapListeners(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
 			return fmt.Errorf("mapping index %d, field serverNameIndicators: %w", i, err)
 		}
 
+		listenerMap["tcp"] = types.ObjectNull(protocolOptionsTypes)
+		if listenerResp.Tcp != nil {
+			listenerMap["tcp"], err = mapProtocolOptions(listenerResp.Tcp.IdleTimeout)
+			if err != nil {
+				return fmt.Errorf("mapping index %d, field tcp: %w", i, err)
+			}
+		}
+		listenerMap["udp"] = types.ObjectNull(protocolOptionsTypes)
+		if listenerResp.Udp != nil {
+			listenerMap["udp"], err = mapProtocolOptions(listenerResp.Udp.IdleTimeout)
+			if err != nil {
+				return fmt.Errorf("mapping index %d, field udp: %w", i, err)
+			}
+		}
+
 		listenerTF, diags := types.ObjectValue(listenerTypes, listenerMap)
 		if diags.HasError() {
 			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
@@ -1100,6 +1381,16 @@ func mapListeners(loadBalancerResp *loadbalancer.LoadBalancer, m *Model) error {
 	return nil
 }
 
+func mapProtocolOptions(idleTimeout *string) (basetypes.ObjectValue, error) {
+	protocolOptionsTF, diags := types.ObjectValue(protocolOptionsTypes, map[string]attr.Value{
+		"idle_timeout": types.StringPointerValue(idleTimeout),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(protocolOptionsTypes), core.DiagsToError(diags)
+	}
+	return protocolOptionsTF, nil
+}
+
 func mapServerNameIndicators(serverNameIndicatorsResp *[]loadbalancer.ServerNameIndicator, l map[string]attr.Value) error {
 	if serverNameIndicatorsResp == nil || *serverNameIndicatorsResp == nil {
 		l["server_name_indicators"] = types.ListNull(types.ObjectType{AttrTypes: serverNameIndicatorTypes})
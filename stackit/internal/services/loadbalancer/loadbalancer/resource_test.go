@@ -55,6 +55,10 @@ func TestToCreatePayload(t *testing.T) {
 						},
 						),
 						"target_pool": types.StringValue("target_pool"),
+						"tcp": types.ObjectValueMust(protocolOptionsTypes, map[string]attr.Value{
+							"idle_timeout": types.StringValue("60s"),
+						}),
+						"udp": types.ObjectNull(protocolOptionsTypes),
 					}),
 				}),
 				Name: types.StringValue("name"),
@@ -113,6 +117,9 @@ func TestToCreatePayload(t *testing.T) {
 							},
 						},
 						TargetPool: utils.Ptr("target_pool"),
+						Tcp: &loadbalancer.OptionsTCP{
+							IdleTimeout: utils.Ptr("60s"),
+						},
 					},
 				},
 				Name: utils.Ptr("name"),
@@ -292,6 +299,7 @@ func TestMapFields(t *testing.T) {
 			nil,
 			&Model{
 				Id:              types.StringValue("pid,name"),
+				ImportId:        types.StringValue("pid,name"),
 				ProjectId:       types.StringValue("pid"),
 				ExternalAddress: types.StringNull(),
 				Listeners:       types.ListNull(types.ObjectType{AttrTypes: listenerTypes}),
@@ -321,6 +329,9 @@ func TestMapFields(t *testing.T) {
 							},
 						},
 						TargetPool: utils.Ptr("target_pool"),
+						Tcp: &loadbalancer.OptionsTCP{
+							IdleTimeout: utils.Ptr("60s"),
+						},
 					},
 				}),
 				Name: utils.Ptr("name"),
@@ -359,10 +370,12 @@ func TestMapFields(t *testing.T) {
 						}),
 					},
 				}),
+				Status: utils.Ptr("STATUS_READY"),
 			},
 			nil,
 			&Model{
 				Id:              types.StringValue("pid,name"),
+				ImportId:        types.StringValue("pid,name"),
 				ProjectId:       types.StringValue("pid"),
 				ExternalAddress: types.StringValue("external_address"),
 				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, []attr.Value{
@@ -380,9 +393,14 @@ func TestMapFields(t *testing.T) {
 						},
 						),
 						"target_pool": types.StringValue("target_pool"),
+						"tcp": types.ObjectValueMust(protocolOptionsTypes, map[string]attr.Value{
+							"idle_timeout": types.StringValue("60s"),
+						}),
+						"udp": types.ObjectNull(protocolOptionsTypes),
 					}),
 				}),
-				Name: types.StringValue("name"),
+				Status: types.StringValue("STATUS_READY"),
+				Name:   types.StringValue("name"),
 				Networks: types.ListValueMust(types.ObjectType{AttrTypes: networkTypes}, []attr.Value{
 					types.ObjectValueMust(networkTypes, map[string]attr.Value{
 						"network_id": types.StringValue("network_id"),
@@ -485,6 +503,7 @@ func TestMapFields(t *testing.T) {
 			utils.Ptr(false),
 			&Model{
 				Id:              types.StringValue("pid,name"),
+				ImportId:        types.StringValue("pid,name"),
 				ProjectId:       types.StringValue("pid"),
 				ExternalAddress: types.StringValue("external_address"),
 				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, []attr.Value{
@@ -502,6 +521,8 @@ func TestMapFields(t *testing.T) {
 						},
 						),
 						"target_pool": types.StringValue("target_pool"),
+						"tcp":         types.ObjectNull(protocolOptionsTypes),
+						"udp":         types.ObjectNull(protocolOptionsTypes),
 					}),
 				}),
 				Name: types.StringValue("name"),
@@ -591,3 +612,159 @@ func TestMapFields(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckListenerTargetPools(t *testing.T) {
+	newListener := func(targetPoolName types.String) attr.Value {
+		return types.ObjectValueMust(listenerTypes, map[string]attr.Value{
+			"display_name":           types.StringNull(),
+			"port":                   types.Int64Value(80),
+			"protocol":               types.StringValue("PROTOCOL_TCP"),
+			"server_name_indicators": types.ListNull(types.ObjectType{AttrTypes: serverNameIndicatorTypes}),
+			"target_pool":            targetPoolName,
+			"tcp":                    types.ObjectNull(protocolOptionsTypes),
+			"udp":                    types.ObjectNull(protocolOptionsTypes),
+		})
+	}
+	newTargetPool := func(name types.String) attr.Value {
+		return types.ObjectValueMust(targetPoolTypes, map[string]attr.Value{
+			"active_health_check": types.ObjectNull(activeHealthCheckTypes),
+			"name":                name,
+			"target_port":         types.Int64Value(80),
+			"targets":             types.ListNull(types.ObjectType{AttrTypes: targetTypes}),
+			"session_persistence": types.ObjectNull(sessionPersistenceTypes),
+		})
+	}
+
+	tests := []struct {
+		description string
+		input       *Model
+		isValid     bool
+	}{
+		{
+			"null values",
+			&Model{
+				Listeners:   types.ListNull(types.ObjectType{AttrTypes: listenerTypes}),
+				TargetPools: types.ListNull(types.ObjectType{AttrTypes: targetPoolTypes}),
+			},
+			true,
+		},
+		{
+			"matching target pool",
+			&Model{
+				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, []attr.Value{
+					newListener(types.StringValue("pool-1")),
+					newListener(types.StringValue("pool-2")),
+				}),
+				TargetPools: types.ListValueMust(types.ObjectType{AttrTypes: targetPoolTypes}, []attr.Value{
+					newTargetPool(types.StringValue("pool-1")),
+					newTargetPool(types.StringValue("pool-2")),
+				}),
+			},
+			true,
+		},
+		{
+			"unknown target pool name",
+			&Model{
+				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, []attr.Value{
+					newListener(types.StringValue("pool-1")),
+				}),
+				TargetPools: types.ListValueMust(types.ObjectType{AttrTypes: targetPoolTypes}, []attr.Value{
+					newTargetPool(types.StringUnknown()),
+				}),
+			},
+			true,
+		},
+		{
+			"missing target pool",
+			&Model{
+				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, []attr.Value{
+					newListener(types.StringValue("pool-1")),
+					newListener(types.StringValue("pool-3")),
+				}),
+				TargetPools: types.ListValueMust(types.ObjectType{AttrTypes: targetPoolTypes}, []attr.Value{
+					newTargetPool(types.StringValue("pool-1")),
+				}),
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := checkListenerTargetPools(context.Background(), tt.input)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestCheckListenerProtocolOptions(t *testing.T) {
+	protocolOptionsValue := types.ObjectValueMust(protocolOptionsTypes, map[string]attr.Value{
+		"idle_timeout": types.StringValue("300s"),
+	})
+	newListener := func(protocol types.String, tcp, udp types.Object) attr.Value {
+		return types.ObjectValueMust(listenerTypes, map[string]attr.Value{
+			"display_name":           types.StringNull(),
+			"port":                   types.Int64Value(80),
+			"protocol":               protocol,
+			"server_name_indicators": types.ListNull(types.ObjectType{AttrTypes: serverNameIndicatorTypes}),
+			"target_pool":            types.StringValue("pool"),
+			"tcp":                    tcp,
+			"udp":                    udp,
+		})
+	}
+
+	tests := []struct {
+		description string
+		input       attr.Value
+		isValid     bool
+	}{
+		{
+			"no options",
+			newListener(types.StringValue("PROTOCOL_UDP"), types.ObjectNull(protocolOptionsTypes), types.ObjectNull(protocolOptionsTypes)),
+			true,
+		},
+		{
+			"tcp options with tcp protocol",
+			newListener(types.StringValue("PROTOCOL_TCP"), protocolOptionsValue, types.ObjectNull(protocolOptionsTypes)),
+			true,
+		},
+		{
+			"udp options with udp protocol",
+			newListener(types.StringValue("PROTOCOL_UDP"), types.ObjectNull(protocolOptionsTypes), protocolOptionsValue),
+			true,
+		},
+		{
+			"unknown protocol",
+			newListener(types.StringUnknown(), protocolOptionsValue, protocolOptionsValue),
+			true,
+		},
+		{
+			"tcp options with udp protocol",
+			newListener(types.StringValue("PROTOCOL_UDP"), protocolOptionsValue, types.ObjectNull(protocolOptionsTypes)),
+			false,
+		},
+		{
+			"udp options with tcp protocol",
+			newListener(types.StringValue("PROTOCOL_TLS_PASSTHROUGH"), types.ObjectNull(protocolOptionsTypes), protocolOptionsValue),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				Listeners: types.ListValueMust(types.ObjectType{AttrTypes: listenerTypes}, []attr.Value{tt.input}),
+			}
+			diags := checkListenerProtocolOptions(context.Background(), model)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ var (
 
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
+	ImportId       types.String `tfsdk:"import_id"`
 	ProjectId      types.String `tfsdk:"project_id"`
 	DisplayName    types.String `tfsdk:"display_name"`
 	Username       types.String `tfsdk:"username"`
@@ -71,12 +72,12 @@ func (r *observabilityCredentialResource) Configure(ctx context.Context, req res
 	var err error
 	if providerData.LoadBalancerCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "loadbalancer_custom_endpoint", providerData.LoadBalancerCustomEndpoint)
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.LoadBalancerCustomEndpoint),
 		)
 	} else {
-		apiClient, err = loadbalancer.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, loadbalancer.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -96,6 +97,7 @@ func (r *observabilityCredentialResource) Schema(_ context.Context, _ resource.S
 	descriptions := map[string]string{
 		"main":            "Load balancer observability credential resource schema. Must have a `region` specified in the provider configuration. These contain the username and password for the observability service (e.g. Argus) where the load balancer logs/metrics will be pushed into",
 		"id":              "Terraform's internal resource ID. It is structured as \"`project_id`\",\"`credentials_ref`\".",
+		"import_id":       "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"credentials_ref": "The credentials reference is used by the Load Balancer to define which credentials it will use.",
 		"project_id":      "STACKIT project ID to which the load balancer observability credential is associated.",
 		"display_name":    "Observability credential name.",
@@ -113,6 +115,13 @@ func (r *observabilityCredentialResource) Schema(_ context.Context, _ resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"credentials_ref": schema.StringAttribute{
 				Description: descriptions["credentials_ref"],
 				Computed:    true,
@@ -177,7 +186,7 @@ func (r *observabilityCredentialResource) Create(ctx context.Context, req resour
 	// Create new observability credentials
 	createResp, err := r.client.CreateCredentials(ctx, projectId).CreateCredentialsPayload(*payload).XRequestID(uuid.NewString()).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating observability credential", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating observability credential", err, req.Plan)
 		return
 	}
 	ctx = tflog.SetField(ctx, "credentials_ref", createResp.Credential.CredentialsRef)
@@ -333,6 +342,7 @@ func mapFields(cred *loadbalancer.CredentialsResponse, m *Model) error {
 	m.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	m.ImportId = m.Id
 
 	return nil
 }
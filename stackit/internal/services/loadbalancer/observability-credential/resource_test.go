@@ -81,6 +81,7 @@ func TestMapFields(t *testing.T) {
 			},
 			&Model{
 				Id:             types.StringValue("pid,credentials_ref"),
+				ImportId:       types.StringValue("pid,credentials_ref"),
 				ProjectId:      types.StringValue("pid"),
 				CredentialsRef: types.StringValue("credentials_ref"),
 				Username:       types.StringValue("username"),
@@ -97,6 +98,7 @@ func TestMapFields(t *testing.T) {
 			},
 			&Model{
 				Id:             types.StringValue("pid,credentials_ref"),
+				ImportId:       types.StringValue("pid,credentials_ref"),
 				ProjectId:      types.StringValue("pid"),
 				CredentialsRef: types.StringValue("credentials_ref"),
 				DisplayName:    types.StringValue("display_name"),
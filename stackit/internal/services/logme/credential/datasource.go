@@ -53,12 +53,12 @@ func (r *credentialDataSource) Configure(ctx context.Context, req datasource.Con
 	var apiClient *logme.APIClient
 	var err error
 	if providerData.LogMeCustomEndpoint != "" {
-		apiClient, err = logme.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, logme.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.LogMeCustomEndpoint),
 		)
 	} else {
-		apiClient, err = logme.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, logme.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -78,6 +78,7 @@ func (r *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	descriptions := map[string]string{
 		"main":          "LogMe credential data source schema. Must have a `region` specified in the provider configuration.",
 		"id":            "Terraform's internal data source. identifier. It is structured as \"`project_id`,`instance_id`,`credential_id`\".",
+		"import_id":     "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"credential_id": "The credential's ID.",
 		"instance_id":   "ID of the LogMe instance.",
 		"project_id":    "STACKIT project ID to which the instance is associated.",
@@ -90,6 +91,10 @@ func (r *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"credential_id": schema.StringAttribute{
 				Description: descriptions["credential_id"],
 				Required:    true,
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,10 +29,12 @@ var (
 	_ resource.Resource                = &credentialResource{}
 	_ resource.ResourceWithConfigure   = &credentialResource{}
 	_ resource.ResourceWithImportState = &credentialResource{}
+	_ resource.ResourceWithModifyPlan  = &credentialResource{}
 )
 
 type Model struct {
 	Id           types.String `tfsdk:"id"` // needed by TF
+	ImportId     types.String `tfsdk:"import_id"`
 	CredentialId types.String `tfsdk:"credential_id"`
 	InstanceId   types.String `tfsdk:"instance_id"`
 	ProjectId    types.String `tfsdk:"project_id"`
@@ -49,7 +52,8 @@ func NewCredentialResource() resource.Resource {
 
 // credentialResource is the resource implementation.
 type credentialResource struct {
-	client *logme.APIClient
+	client       *logme.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -64,7 +68,8 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -72,15 +77,15 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 
 	var apiClient *logme.APIClient
 	var err error
-	if providerData.LogMeCustomEndpoint != "" {
-		apiClient, err = logme.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.LogMeCustomEndpoint),
+	if r.providerData.LogMeCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, logme.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.LogMeCustomEndpoint),
 		)
 	} else {
-		apiClient, err = logme.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, logme.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -93,11 +98,20 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 	tflog.Info(ctx, "LogMe credential client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled.
+func (r *credentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		utils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":          "LogMe credential resource schema. Must have a `region` specified in the provider configuration.",
 		"id":            "Terraform's internal resource identifier. It is structured as \"`project_id`,`instance_id`,`credential_id`\".",
+		"import_id":     "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"credential_id": "The credential's ID.",
 		"instance_id":   "ID of the LogMe instance.",
 		"project_id":    "STACKIT Project ID to which the instance is associated.",
@@ -113,6 +127,13 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"credential_id": schema.StringAttribute{
 				Description: descriptions["credential_id"],
 				Computed:    true,
@@ -185,7 +206,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create new recordset
 	credentialsResp, err := r.client.CreateCredentials(ctx, projectId, instanceId).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating credential", err, req.Plan)
 		return
 	}
 	if credentialsResp.Id == nil {
@@ -195,7 +216,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	credentialId := *credentialsResp.Id
 	ctx = tflog.SetField(ctx, "credential_id", credentialId)
 
-	waitResp, err := wait.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId), "LogMe credentials creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -207,6 +228,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString(), model.Uri.ValueString())
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -223,6 +245,7 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString(), model.Uri.ValueString())
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
 	credentialId := model.CredentialId.ValueString()
@@ -271,6 +294,7 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString(), model.Uri.ValueString())
 
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
@@ -284,7 +308,7 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", fmt.Sprintf("Calling API: %v", err))
 	}
-	_, err = wait.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId), "LogMe credentials deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -339,6 +363,7 @@ func mapFields(credentialsResp *logme.CredentialsResponse, model *Model) error {
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.CredentialId = types.StringValue(credentialId)
 	if credentials != nil {
 		model.Host = types.StringPointerValue(credentials.Host)
@@ -24,6 +24,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -51,6 +52,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -78,6 +80,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -33,11 +34,13 @@ import (
 var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
 )
 
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
+	ImportId           types.String `tfsdk:"import_id"`
 	InstanceId         types.String `tfsdk:"instance_id"`
 	ProjectId          types.String `tfsdk:"project_id"`
 	CfGuid             types.String `tfsdk:"cf_guid"`
@@ -50,6 +53,7 @@ type Model struct {
 	Version            types.String `tfsdk:"version"`
 	PlanName           types.String `tfsdk:"plan_name"`
 	PlanId             types.String `tfsdk:"plan_id"`
+	Status             types.String `tfsdk:"status"`
 }
 
 // Struct corresponding to DataSourceModel.Parameters
@@ -111,7 +115,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *logme.APIClient
+	client       *logme.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -135,12 +140,12 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var apiClient *logme.APIClient
 	var err error
 	if providerData.LogMeCustomEndpoint != "" {
-		apiClient, err = logme.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, logme.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.LogMeCustomEndpoint),
 		)
 	} else {
-		apiClient, err = logme.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, logme.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -152,20 +157,29 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "LogMe instance client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":        "LogMe instance resource schema. Must have a `region` specified in the provider configuration.",
 		"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":   "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id": "ID of the LogMe instance.",
 		"project_id":  "STACKIT project ID to which the instance is associated.",
 		"name":        "Instance name.",
 		"version":     "The service version.",
 		"plan_name":   "The selected plan name.",
 		"plan_id":     "The selected plan ID.",
+		"status":      "The status of the instance, e.g. `active` or `failed`.",
 		"parameters":  "Configuration parameters. Please note that removing a previously configured field from your Terraform configuration won't replace its value in the API. To update a previously configured field, explicitly set a new value for it.",
 	}
 
@@ -195,6 +209,13 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Computed:    true,
@@ -241,6 +262,10 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: descriptions["plan_id"],
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"parameters": schema.SingleNestedAttribute{
 				Description: descriptions["parameters"],
 				Attributes: map[string]schema.Attribute{
@@ -435,12 +460,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating instance", err, req.Plan)
 		return
 	}
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(90 * time.Minute).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(90*time.Minute), "LogMe instance creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -547,10 +572,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update existing instance
 	err = r.client.PartialUpdateInstance(ctx, projectId, instanceId).PartialUpdateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating instance", err, req.Plan)
 		return
 	}
-	waitResp, err := wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId), "LogMe instance update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
@@ -591,7 +616,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId), "LogMe instance deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -641,6 +666,7 @@ func mapFields(instance *logme.Instance, model *Model) error {
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanId = types.StringPointerValue(instance.PlanId)
 	model.CfGuid = types.StringPointerValue(instance.CfGuid)
@@ -649,6 +675,7 @@ func mapFields(instance *logme.Instance, model *Model) error {
 	model.ImageUrl = types.StringPointerValue(instance.ImageUrl)
 	model.Name = types.StringPointerValue(instance.Name)
 	model.CfOrganizationGuid = types.StringPointerValue(instance.CfOrganizationGuid)
+	model.Status = types.StringPointerValue(instance.Status)
 
 	if instance.Parameters == nil {
 		model.Parameters = types.ObjectNull(parametersTypes)
@@ -108,6 +108,7 @@ func TestMapFields(t *testing.T) {
 			&logme.Instance{},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringNull(),
@@ -117,6 +118,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringNull(),
 				ImageUrl:           types.StringNull(),
 				CfOrganizationGuid: types.StringNull(),
+				Status:             types.StringNull(),
 				Parameters:         types.ObjectNull(parametersTypes),
 			},
 			true,
@@ -132,6 +134,7 @@ func TestMapFields(t *testing.T) {
 				InstanceId:         utils.Ptr("iid"),
 				Name:               utils.Ptr("name"),
 				CfOrganizationGuid: utils.Ptr("org"),
+				Status:             utils.Ptr("active"),
 				Parameters: &map[string]interface{}{
 					// Using "-" on purpose on some fields because that is the API response
 					"sgw_acl":                  "acl",
@@ -160,6 +163,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringValue("plan"),
@@ -169,6 +173,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringValue("dashboard"),
 				ImageUrl:           types.StringValue("image"),
 				CfOrganizationGuid: types.StringValue("org"),
+				Status:             types.StringValue("active"),
 				Parameters:         fixtureModelParameters,
 			},
 			true,
@@ -29,10 +29,12 @@ var (
 	_ resource.Resource                = &credentialResource{}
 	_ resource.ResourceWithConfigure   = &credentialResource{}
 	_ resource.ResourceWithImportState = &credentialResource{}
+	_ resource.ResourceWithModifyPlan  = &credentialResource{}
 )
 
 type Model struct {
 	Id           types.String `tfsdk:"id"` // needed by TF
+	ImportId     types.String `tfsdk:"import_id"`
 	CredentialId types.String `tfsdk:"credential_id"`
 	InstanceId   types.String `tfsdk:"instance_id"`
 	ProjectId    types.String `tfsdk:"project_id"`
@@ -52,7 +54,8 @@ func NewCredentialResource() resource.Resource {
 
 // credentialResource is the resource implementation.
 type credentialResource struct {
-	client *mariadb.APIClient
+	client       *mariadb.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -67,7 +70,8 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -75,15 +79,15 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 
 	var apiClient *mariadb.APIClient
 	var err error
-	if providerData.MariaDBCustomEndpoint != "" {
-		apiClient, err = mariadb.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.MariaDBCustomEndpoint),
+	if r.providerData.MariaDBCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, mariadb.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.MariaDBCustomEndpoint),
 		)
 	} else {
-		apiClient, err = mariadb.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, mariadb.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -96,11 +100,20 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 	tflog.Info(ctx, "MariaDB credential client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled.
+func (r *credentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		utils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":          "MariaDB credential resource schema. Must have a `region` specified in the provider configuration.",
 		"id":            "Terraform's internal resource identifier. It is structured as \"`project_id`,`instance_id`,`credential_id`\".",
+		"import_id":     "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"credential_id": "The credential's ID.",
 		"instance_id":   "ID of the MariaDB instance.",
 		"project_id":    "STACKIT Project ID to which the instance is associated.",
@@ -116,6 +129,13 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"credential_id": schema.StringAttribute{
 				Description: descriptions["credential_id"],
 				Computed:    true,
@@ -195,7 +215,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create new recordset
 	credentialsResp, err := r.client.CreateCredentials(ctx, projectId, instanceId).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating credential", err, req.Plan)
 		return
 	}
 	if credentialsResp.Id == nil {
@@ -205,7 +225,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	credentialId := *credentialsResp.Id
 	ctx = tflog.SetField(ctx, "credential_id", credentialId)
 
-	waitResp, err := wait.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId), "MariaDB credentials creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -217,6 +237,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString(), model.Uri.ValueString())
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -233,6 +254,7 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString(), model.Uri.ValueString())
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
 	credentialId := model.CredentialId.ValueString()
@@ -281,6 +303,7 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Password.ValueString(), model.Uri.ValueString())
 
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
@@ -294,7 +317,7 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", fmt.Sprintf("Calling API: %v", err))
 	}
-	_, err = wait.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId), "MariaDB credentials deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -349,6 +372,7 @@ func mapFields(ctx context.Context, credentialsResp *mariadb.CredentialsResponse
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 
 	modelHosts, err := utils.ListValuetoStringSlice(model.Hosts)
 	if err != nil {
@@ -60,6 +60,7 @@ func TestMapFields(t *testing.T) {
 			&mariadb.Instance{},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringNull(),
@@ -69,6 +70,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringNull(),
 				ImageUrl:           types.StringNull(),
 				CfOrganizationGuid: types.StringNull(),
+				Status:             types.StringNull(),
 				Parameters:         types.ObjectNull(parametersTypes),
 			},
 			true,
@@ -84,6 +86,7 @@ func TestMapFields(t *testing.T) {
 				InstanceId:         utils.Ptr("iid"),
 				Name:               utils.Ptr("name"),
 				CfOrganizationGuid: utils.Ptr("org"),
+				Status:             utils.Ptr("active"),
 				Parameters: &map[string]interface{}{
 					"sgw_acl":                "acl",
 					"enable_monitoring":      true,
@@ -97,6 +100,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringValue("plan"),
@@ -106,6 +110,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringValue("dashboard"),
 				ImageUrl:           types.StringValue("image"),
 				CfOrganizationGuid: types.StringValue("org"),
+				Status:             types.StringValue("active"),
 				Parameters:         fixtureModelParameters,
 			},
 			true,
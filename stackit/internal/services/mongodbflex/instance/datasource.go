@@ -55,12 +55,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var apiClient *mongodbflex.APIClient
 	var err error
 	if providerData.MongoDBFlexCustomEndpoint != "" {
-		apiClient, err = mongodbflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, mongodbflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.MongoDBFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = mongodbflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, mongodbflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -80,10 +80,12 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	descriptions := map[string]string{
 		"main":                              "MongoDB Flex instance data source schema. Must have a `region` specified in the provider configuration.",
 		"id":                                "Terraform's internal data source ID. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":                         "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id":                       "ID of the MongoDB Flex instance.",
 		"project_id":                        "STACKIT project ID to which the instance is associated.",
 		"name":                              "Instance name.",
 		"acl":                               "The Access Control List (ACL) for the MongoDB Flex instance.",
+		"status":                            "Status of the MongoDB Flex instance, e.g. `READY`.",
 		"backup_schedule":                   `The backup schedule. Should follow the cron scheduling system format (e.g. "0 0 * * *").`,
 		"options":                           "Custom parameters for the MongoDB Flex instance.",
 		"type":                              "Type of the MongoDB Flex instance.",
@@ -101,6 +103,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Required:    true,
@@ -164,6 +170,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"version": schema.StringAttribute{
 				Computed: true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"options": schema.SingleNestedAttribute{
 				Description: descriptions["options"],
 				Computed:    true,
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -41,11 +42,13 @@ const (
 var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
 )
 
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
+	ImportId       types.String `tfsdk:"import_id"`
 	InstanceId     types.String `tfsdk:"instance_id"`
 	ProjectId      types.String `tfsdk:"project_id"`
 	Name           types.String `tfsdk:"name"`
@@ -55,9 +58,16 @@ type Model struct {
 	Replicas       types.Int64  `tfsdk:"replicas"`
 	Storage        types.Object `tfsdk:"storage"`
 	Version        types.String `tfsdk:"version"`
+	Status         types.String `tfsdk:"status"`
 	Options        types.Object `tfsdk:"options"`
 }
 
+// ResourceModel extends the Model shared with the data source by the resource-only timeouts block
+type ResourceModel struct {
+	Model
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 // Struct corresponding to Model.Flavor
 type flavorModel struct {
 	Id          types.String `tfsdk:"id"`
@@ -113,7 +123,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *mongodbflex.APIClient
+	client       *mongodbflex.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -137,12 +148,12 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var apiClient *mongodbflex.APIClient
 	var err error
 	if providerData.MongoDBFlexCustomEndpoint != "" {
-		apiClient, err = mongodbflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, mongodbflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.MongoDBFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = mongodbflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, mongodbflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -154,9 +165,16 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "MongoDB Flex instance client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	typeOptions := []string{"Replica", "Sharded", "Single"}
@@ -164,10 +182,12 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	descriptions := map[string]string{
 		"main":                              "MongoDB Flex instance resource schema. Must have a `region` specified in the provider configuration.",
 		"id":                                "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":                         "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id":                       "ID of the MongoDB Flex instance.",
 		"project_id":                        "STACKIT project ID to which the instance is associated.",
 		"name":                              "Instance name.",
 		"acl":                               "The Access Control List (ACL) for the MongoDB Flex instance.",
+		"status":                            "Status of the MongoDB Flex instance, e.g. `READY`.",
 		"backup_schedule":                   `The backup schedule. Should follow the cron scheduling system format (e.g. "0 0 * * *").`,
 		"options":                           "Custom parameters for the MongoDB Flex instance.",
 		"type":                              fmt.Sprintf("Type of the MongoDB Flex instance. %s", utils.SupportedValuesDocumentation(typeOptions)),
@@ -188,6 +208,13 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Computed:    true,
@@ -268,6 +295,10 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"version": schema.StringAttribute{
 				Required: true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"options": schema.SingleNestedAttribute{
 				Required: true,
 				Attributes: map[string]schema.Attribute{
@@ -277,6 +308,9 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(typeOptions...),
+						},
 					},
 					"snapshot_retention_days": schema.Int64Attribute{
 						Description: descriptions["snapshot_retention_days"],
@@ -285,6 +319,9 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"daily_snapshot_retention_days": schema.Int64Attribute{
 						Description: descriptions["daily_snapshot_retention_days"],
@@ -293,6 +330,9 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"weekly_snapshot_retention_weeks": schema.Int64Attribute{
 						Description: descriptions["weekly_snapshot_retention_weeks"],
@@ -301,6 +341,9 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"monthly_snapshot_retention_months": schema.Int64Attribute{
 						Description: descriptions["monthly_snapshot_retention_months"],
@@ -309,6 +352,9 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"point_in_time_window_hours": schema.Int64Attribute{
 						Description: descriptions["point_in_time_window_hours"],
@@ -317,17 +363,23 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(),
+		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -336,6 +388,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
+	createTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutCreate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	var acl []string
 	if !(model.ACL.IsNull() || model.ACL.IsUnknown()) {
 		diags = model.ACL.ElementsAs(ctx, &acl, false)
@@ -351,7 +409,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		err := loadFlavorId(ctx, r.client, &model, flavor)
+		err := loadFlavorId(ctx, r.client, &model.Model, flavor)
 		if err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Loading flavor ID: %v", err))
 			return
@@ -376,7 +434,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(&model, acl, flavor, storage, options)
+	payload, err := toCreatePayload(&model.Model, acl, flavor, storage, options)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -384,25 +442,25 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating instance", err, req.Plan)
 		return
 	}
 	instanceId := *createResp.Id
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, utils.WithTimeout(wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId), createTimeout), "MongoDB Flex instance creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model, flavor, storage, options)
+	err = mapFields(ctx, waitResp, &model.Model, flavor, storage, options)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
 
-	backupScheduleOptionsPayload, err := toUpdateBackupScheduleOptionsPayload(ctx, &model, options)
+	backupScheduleOptionsPayload, err := toUpdateBackupScheduleOptionsPayload(ctx, &model.Model, options)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -413,7 +471,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	err = mapOptions(&model, options, backupScheduleOptions)
+	err = mapOptions(&model.Model, options, backupScheduleOptions)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API response: %v", err))
 		return
@@ -430,7 +488,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -479,11 +537,15 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, instanceResp, &model, flavor, storage, options)
+	err = mapFields(ctx, instanceResp, &model.Model, flavor, storage, options)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	if model.Status.ValueString() == wait.InstanceStateFailed {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "MongoDB Flex instance is in a failed state",
+			"The last operation on the instance has failed. Check the instance in the STACKIT portal and, if it does not recover, recreate it with `terraform apply -replace` (or `terraform taint`).")
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -496,7 +558,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *instanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -507,6 +569,12 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	updateTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutUpdate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	var acl []string
 	if !(model.ACL.IsNull() || model.ACL.IsUnknown()) {
 		diags = model.ACL.ElementsAs(ctx, &acl, false)
@@ -522,7 +590,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		err := loadFlavorId(ctx, r.client, &model, flavor)
+		err := loadFlavorId(ctx, r.client, &model.Model, flavor)
 		if err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Loading flavor ID: %v", err))
 			return
@@ -547,7 +615,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model, acl, flavor, storage, options)
+	payload, err := toUpdatePayload(&model.Model, acl, flavor, storage, options)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -558,20 +626,20 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	waitResp, err := wait.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, utils.WithTimeout(wait.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId), updateTimeout), "MongoDB Flex instance update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model, flavor, storage, options)
+	err = mapFields(ctx, waitResp, &model.Model, flavor, storage, options)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
 
-	backupScheduleOptionsPayload, err := toUpdateBackupScheduleOptionsPayload(ctx, &model, options)
+	backupScheduleOptionsPayload, err := toUpdateBackupScheduleOptionsPayload(ctx, &model.Model, options)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -582,7 +650,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	err = mapOptions(&model, options, backupScheduleOptions)
+	err = mapOptions(&model.Model, options, backupScheduleOptions)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API response: %v", err))
 		return
@@ -599,7 +667,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -610,13 +678,19 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	deleteTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutDelete)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	// Delete existing instance
-	err := r.client.DeleteInstance(ctx, projectId, instanceId).Execute()
+	err = r.client.DeleteInstance(ctx, projectId, instanceId).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, utils.WithTimeout(wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId), deleteTimeout), "MongoDB Flex instance deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -790,6 +864,7 @@ func mapFields(ctx context.Context, resp *mongodbflex.GetInstanceResponse, model
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.InstanceId = types.StringValue(instanceId)
 	model.Name = types.StringPointerValue(instance.Name)
 	model.ACL = aclList
@@ -797,6 +872,7 @@ func mapFields(ctx context.Context, resp *mongodbflex.GetInstanceResponse, model
 	model.Replicas = types.Int64PointerValue(instance.Replicas)
 	model.Storage = storageObject
 	model.Version = types.StringPointerValue(instance.Version)
+	model.Status = types.StringPointerValue(instance.Status)
 	model.Options = optionsObject
 	return nil
 }
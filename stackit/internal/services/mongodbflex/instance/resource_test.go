@@ -51,6 +51,7 @@ func TestMapFields(t *testing.T) {
 			&optionsModel{},
 			Model{
 				Id:             types.StringValue("pid,iid"),
+				ImportId:       types.StringValue("pid,iid"),
 				InstanceId:     types.StringValue("iid"),
 				ProjectId:      types.StringValue("pid"),
 				Name:           types.StringNull(),
@@ -125,6 +126,7 @@ func TestMapFields(t *testing.T) {
 			&optionsModel{},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -154,6 +156,7 @@ func TestMapFields(t *testing.T) {
 					"point_in_time_window_hours":        types.Int64Value(9),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
 			},
 			true,
 		},
@@ -203,6 +206,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -232,6 +236,7 @@ func TestMapFields(t *testing.T) {
 					"point_in_time_window_hours":        types.Int64Value(9),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
 			},
 			true,
 		},
@@ -286,6 +291,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -315,6 +321,7 @@ func TestMapFields(t *testing.T) {
 					"point_in_time_window_hours":        types.Int64Value(9),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
 			},
 			true,
 		},
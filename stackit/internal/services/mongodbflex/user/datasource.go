@@ -68,12 +68,12 @@ func (r *userDataSource) Configure(ctx context.Context, req datasource.Configure
 	var apiClient *mongodbflex.APIClient
 	var err error
 	if providerData.MongoDBFlexCustomEndpoint != "" {
-		apiClient, err = mongodbflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, mongodbflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.MongoDBFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = mongodbflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, mongodbflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
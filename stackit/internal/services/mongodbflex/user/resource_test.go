@@ -27,6 +27,7 @@ func TestMapFieldsCreate(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -60,6 +61,7 @@ func TestMapFieldsCreate(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -93,6 +95,7 @@ func TestMapFieldsCreate(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -174,6 +177,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -202,6 +206,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -231,6 +236,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -0,0 +1,207 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &bucketsDataSource{}
+)
+
+// NewBucketsDataSource is a helper function to simplify the provider implementation.
+func NewBucketsDataSource() datasource.DataSource {
+	return &bucketsDataSource{}
+}
+
+// bucketsDataSource is the data source implementation.
+type bucketsDataSource struct {
+	client       *objectstorage.APIClient
+	providerData core.ProviderData
+}
+
+// bucketsDataSourceModel maps the data source schema data.
+type bucketsDataSourceModel struct {
+	Id        types.String                 `tfsdk:"id"` // needed by TF
+	ProjectId types.String                 `tfsdk:"project_id"`
+	Region    types.String                 `tfsdk:"region"`
+	Items     []bucketsDataSourceItemModel `tfsdk:"items"`
+}
+
+// bucketsDataSourceItemModel maps bucket schema data.
+type bucketsDataSourceItemModel struct {
+	Name                  types.String `tfsdk:"name"`
+	URLPathStyle          types.String `tfsdk:"url_path_style"`
+	URLVirtualHostedStyle types.String `tfsdk:"url_virtual_hosted_style"`
+}
+
+// Metadata returns the data source type name.
+func (r *bucketsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_buckets"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *bucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *objectstorage.APIClient
+	var err error
+	if r.providerData.ObjectStorageCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "ObjectStorage buckets client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *bucketsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "ObjectStorage buckets data source schema. Lists all buckets of a project in a region. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source identifier. It is structured as \"`project_id`,`region`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT Project ID to which the buckets are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The region of the buckets. If not defined, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The buckets of the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The bucket name.",
+							Computed:    true,
+						},
+						"url_path_style": schema.StringAttribute{
+							Description: "URL in path style.",
+							Computed:    true,
+						},
+						"url_virtual_hosted_style": schema.StringAttribute{
+							Description: "URL in virtual hosted style.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model bucketsDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	var region string
+	if utils.IsUndefined(model.Region) {
+		region = r.providerData.Region
+	} else {
+		region = model.Region.ValueString()
+	}
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	bucketsResp, err := r.client.ListBuckets(ctx, projectId, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading buckets", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapBucketsDataSourceFields(bucketsResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading buckets", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "ObjectStorage buckets read")
+}
+
+func mapBucketsDataSourceFields(bucketsResp *objectstorage.ListBucketsResponse, model *bucketsDataSourceModel, region string) error {
+	if bucketsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	idParts := []string{
+		model.ProjectId.ValueString(),
+		region,
+	}
+	model.Id = types.StringValue(
+		strings.Join(idParts, core.Separator),
+	)
+	model.Region = types.StringValue(region)
+	model.Items = []bucketsDataSourceItemModel{}
+	if bucketsResp.Buckets == nil {
+		return nil
+	}
+	for i := range *bucketsResp.Buckets {
+		bucket := &(*bucketsResp.Buckets)[i]
+		if bucket.Name == nil {
+			return fmt.Errorf("bucket name not present")
+		}
+		model.Items = append(model.Items, bucketsDataSourceItemModel{
+			Name:                  types.StringPointerValue(bucket.Name),
+			URLPathStyle:          types.StringPointerValue(bucket.UrlPathStyle),
+			URLVirtualHostedStyle: types.StringPointerValue(bucket.UrlVirtualHostedStyle),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package objectstorage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
+)
+
+func TestMapBucketsDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *objectstorage.ListBucketsResponse
+		expected    bucketsDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_buckets",
+			&objectstorage.ListBucketsResponse{},
+			bucketsDataSourceModel{
+				Id:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Items:     []bucketsDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&objectstorage.ListBucketsResponse{
+				Buckets: &[]objectstorage.Bucket{
+					{
+						Name:                  utils.Ptr("bucket"),
+						Region:                utils.Ptr("eu01"),
+						UrlPathStyle:          utils.Ptr("url/path/style"),
+						UrlVirtualHostedStyle: utils.Ptr("url/virtual/hosted/style"),
+					},
+					{
+						Name: utils.Ptr("bucket-2"),
+					},
+				},
+			},
+			bucketsDataSourceModel{
+				Id:        types.StringValue("pid,eu01"),
+				ProjectId: types.StringValue("pid"),
+				Region:    types.StringValue("eu01"),
+				Items: []bucketsDataSourceItemModel{
+					{
+						Name:                  types.StringValue("bucket"),
+						URLPathStyle:          types.StringValue("url/path/style"),
+						URLVirtualHostedStyle: types.StringValue("url/virtual/hosted/style"),
+					},
+					{
+						Name:                  types.StringValue("bucket-2"),
+						URLPathStyle:          types.StringNull(),
+						URLVirtualHostedStyle: types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_bucket_name",
+			&objectstorage.ListBucketsResponse{
+				Buckets: &[]objectstorage.Bucket{
+					{},
+				},
+			},
+			bucketsDataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			bucketsDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &bucketsDataSourceModel{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapBucketsDataSourceFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -56,12 +56,12 @@ func (r *bucketDataSource) Configure(ctx context.Context, req datasource.Configu
 	var apiClient *objectstorage.APIClient
 	var err error
 	if r.providerData.ObjectStorageCustomEndpoint != "" {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
 		)
 	} else {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 		)
 	}
@@ -80,11 +80,13 @@ func (r *bucketDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	descriptions := map[string]string{
 		"main":                     "ObjectStorage bucket data source schema. Must have a `region` specified in the provider configuration.",
 		"id":                       "Terraform's internal data source identifier. It is structured as \"`project_id`,`name`\".",
+		"import_id":                "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"name":                     "The bucket name. It must be DNS conform.",
 		"project_id":               "STACKIT Project ID to which the bucket is associated.",
 		"url_path_style":           "URL in path style.",
 		"url_virtual_hosted_style": "URL in virtual hosted style.",
-		"region":                   "The resource region. Read-only attribute that reflects the provider region.",
+		"endpoint":                 "S3 compatible endpoint of the region of the bucket, e.g. `https://object.storage.eu01.onstackit.cloud`. Use it to configure S3 clients instead of hard-coding the regional endpoint.",
+		"region":                   "The resource region. If not defined, the provider region is used.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -94,6 +96,10 @@ func (r *bucketDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"name": schema.StringAttribute{
 				Description: descriptions["name"],
 				Required:    true,
@@ -110,14 +116,20 @@ func (r *bucketDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				},
 			},
 			"url_path_style": schema.StringAttribute{
-				Computed: true,
+				Description: descriptions["url_path_style"],
+				Computed:    true,
 			},
 			"url_virtual_hosted_style": schema.StringAttribute{
-				Computed: true,
+				Description: descriptions["url_virtual_hosted_style"],
+				Computed:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: descriptions["endpoint"],
+				Computed:    true,
 			},
 			"region": schema.StringAttribute{
 				// the region cannot be found automatically, so it has to be passed
-				Optional:    false,
+				Optional:    true,
 				Computed:    true,
 				Description: descriptions["region"],
 			},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -34,10 +35,12 @@ var (
 
 type Model struct {
 	Id                    types.String `tfsdk:"id"` // needed by TF
+	ImportId              types.String `tfsdk:"import_id"`
 	Name                  types.String `tfsdk:"name"`
 	ProjectId             types.String `tfsdk:"project_id"`
 	URLPathStyle          types.String `tfsdk:"url_path_style"`
 	URLVirtualHostedStyle types.String `tfsdk:"url_virtual_hosted_style"`
+	Endpoint              types.String `tfsdk:"endpoint"`
 	Region                types.String `tfsdk:"region"`
 }
 
@@ -53,7 +56,8 @@ type bucketResource struct {
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
-// Use the modifier to set the effective region in the current plan.
+// Use the modifier to set the effective region in the current plan
+// and to validate the project when planning the creation of the bucket, if enabled.
 func (r *bucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
 	var configModel Model
 	// skip initial empty configuration to avoid follow-up errors
@@ -76,10 +80,34 @@ func (r *bucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		return
 	}
 
+	// Warn about an already existing bucket when it is about to be created
+	if r.providerData.EnablePlanTimeChecks && req.State.Raw.IsNull() {
+		r.checkBucketExists(ctx, &planModel, resp)
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
+// checkBucketExists adds a warning to the plan if a bucket with the planned name already exists in the project.
+func (r *bucketResource) checkBucketExists(ctx context.Context, model *Model, resp *resource.ModifyPlanResponse) {
+	if model.ProjectId.IsUnknown() || model.Name.IsUnknown() || model.Region.IsUnknown() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	bucketName := model.Name.ValueString()
+	_, err := r.client.GetBucket(ctx, projectId, model.Region.ValueString(), bucketName).Execute()
+	if err != nil {
+		// A not found error is the expected outcome, any other error will surface during apply
+		tflog.Debug(ctx, fmt.Sprintf("Plan-time check for bucket %q: %v", bucketName, err))
+		return
+	}
+	core.LogAndAddWarning(ctx, &resp.Diagnostics, "Bucket already exists",
+		fmt.Sprintf("A bucket with name %q already exists in project %q, creating it will fail. Import the existing bucket or choose a different name.", bucketName, projectId))
 }
 
 // Metadata returns the resource type name.
@@ -104,12 +132,12 @@ func (r *bucketResource) Configure(ctx context.Context, req resource.ConfigureRe
 	var apiClient *objectstorage.APIClient
 	var err error
 	if r.providerData.ObjectStorageCustomEndpoint != "" {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
 		)
 	} else {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 		)
 	}
@@ -128,11 +156,13 @@ func (r *bucketResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	descriptions := map[string]string{
 		"main":                     "ObjectStorage bucket resource schema. Must have a `region` specified in the provider configuration. If you are creating `credentialsgroup` and `bucket` resources simultaneously, please include the `depends_on` field so that they are created sequentially. This prevents errors from concurrent calls to the service enablement that is done in the background.",
 		"id":                       "Terraform's internal resource identifier. It is structured as \"`project_id`,`name`\".",
+		"import_id":                "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"name":                     "The bucket name. It must be DNS conform.",
 		"project_id":               "STACKIT Project ID to which the bucket is associated.",
 		"url_path_style":           "URL in path style.",
 		"url_virtual_hosted_style": "URL in virtual hosted style.",
-		"region":                   "The resource region. Read-only attribute that reflects the provider region.",
+		"endpoint":                 "S3 compatible endpoint of the region of the bucket, e.g. `https://object.storage.eu01.onstackit.cloud`. Use it to configure S3 clients instead of hard-coding the regional endpoint.",
+		"region":                   "The resource region. If not defined, the provider region is used.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -145,6 +175,13 @@ func (r *bucketResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Description: descriptions["name"],
 				Required:    true,
@@ -169,13 +206,19 @@ func (r *bucketResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"url_path_style": schema.StringAttribute{
-				Computed: true,
+				Description: descriptions["url_path_style"],
+				Computed:    true,
 			},
 			"url_virtual_hosted_style": schema.StringAttribute{
-				Computed: true,
+				Description: descriptions["url_virtual_hosted_style"],
+				Computed:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: descriptions["endpoint"],
+				Computed:    true,
 			},
 			"region": schema.StringAttribute{
-				Optional: false,
+				Optional: true,
 				// must be computed to allow for storing the override value from the provider
 				Computed:    true,
 				Description: descriptions["region"],
@@ -213,11 +256,11 @@ func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Create new bucket
 	_, err = r.client.CreateBucket(ctx, projectId, region, bucketName).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating bucket", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating bucket", err, req.Plan)
 		return
 	}
 
-	waitResp, err := wait.CreateBucketWaitHandler(ctx, r.client, projectId, region, bucketName).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.CreateBucketWaitHandler(ctx, r.client, projectId, region, bucketName), "Object Storage bucket creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating bucket", fmt.Sprintf("Bucket creation waiting: %v", err))
 		return
@@ -310,7 +353,7 @@ func (r *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting bucket", fmt.Sprintf("Calling API: %v", err))
 	}
-	_, err = wait.DeleteBucketWaitHandler(ctx, r.client, projectId, region, bucketName).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteBucketWaitHandler(ctx, r.client, projectId, region, bucketName), "Object Storage bucket deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting bucket", fmt.Sprintf("Bucket deletion waiting: %v", err))
 		return
@@ -354,12 +397,29 @@ func mapFields(bucketResp *objectstorage.GetBucketResponse, model *Model, region
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.URLPathStyle = types.StringPointerValue(bucket.UrlPathStyle)
 	model.URLVirtualHostedStyle = types.StringPointerValue(bucket.UrlVirtualHostedStyle)
+	model.Endpoint = types.StringPointerValue(endpointFromURL(bucket.UrlPathStyle))
 	model.Region = types.StringValue(region)
 	return nil
 }
 
+// endpointFromURL returns the S3 endpoint of a bucket, derived from its URL in path style
+// (e.g. "https://object.storage.eu01.onstackit.cloud/bucket-name" results in "https://object.storage.eu01.onstackit.cloud").
+// Returns nil if the URL is not set or invalid.
+func endpointFromURL(urlPathStyle *string) *string {
+	if urlPathStyle == nil {
+		return nil
+	}
+	u, err := url.Parse(*urlPathStyle)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil
+	}
+	endpoint := fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	return &endpoint
+}
+
 type objectStorageClient interface {
 	EnableServiceExecute(ctx context.Context, projectId, region string) (*objectstorage.ProjectStatus, error)
 }
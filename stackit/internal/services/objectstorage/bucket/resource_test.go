@@ -40,10 +40,12 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                    types.StringValue("pid,bname"),
+				ImportId:              types.StringValue("pid,bname"),
 				Name:                  types.StringValue("bname"),
 				ProjectId:             types.StringValue("pid"),
 				URLPathStyle:          types.StringNull(),
 				URLVirtualHostedStyle: types.StringNull(),
+				Endpoint:              types.StringNull(),
 				Region:                types.StringValue("eu01"),
 			},
 			true,
@@ -58,10 +60,12 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                    types.StringValue("pid,bname"),
+				ImportId:              types.StringValue("pid,bname"),
 				Name:                  types.StringValue("bname"),
 				ProjectId:             types.StringValue("pid"),
 				URLPathStyle:          types.StringValue("url/path/style"),
 				URLVirtualHostedStyle: types.StringValue("url/virtual/hosted/style"),
+				Endpoint:              types.StringNull(),
 				Region:                types.StringValue("eu01"),
 			},
 			true,
@@ -76,10 +80,32 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                    types.StringValue("pid,bname"),
+				ImportId:              types.StringValue("pid,bname"),
 				Name:                  types.StringValue("bname"),
 				ProjectId:             types.StringValue("pid"),
 				URLPathStyle:          types.StringValue(""),
 				URLVirtualHostedStyle: types.StringValue(""),
+				Endpoint:              types.StringNull(),
+				Region:                types.StringValue("eu01"),
+			},
+			true,
+		},
+		{
+			"urls",
+			&objectstorage.GetBucketResponse{
+				Bucket: &objectstorage.Bucket{
+					UrlPathStyle:          utils.Ptr("https://object.storage.eu01.onstackit.cloud/bname"),
+					UrlVirtualHostedStyle: utils.Ptr("https://bname.object.storage.eu01.onstackit.cloud"),
+				},
+			},
+			Model{
+				Id:                    types.StringValue("pid,bname"),
+				ImportId:              types.StringValue("pid,bname"),
+				Name:                  types.StringValue("bname"),
+				ProjectId:             types.StringValue("pid"),
+				URLPathStyle:          types.StringValue("https://object.storage.eu01.onstackit.cloud/bname"),
+				URLVirtualHostedStyle: types.StringValue("https://bname.object.storage.eu01.onstackit.cloud"),
+				Endpoint:              types.StringValue("https://object.storage.eu01.onstackit.cloud"),
 				Region:                types.StringValue("eu01"),
 			},
 			true,
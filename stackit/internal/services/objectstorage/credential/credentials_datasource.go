@@ -0,0 +1,235 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &credentialsDataSource{}
+)
+
+// credentialsDataSourceModel maps the data source schema data.
+type credentialsDataSourceModel struct {
+	Id                 types.String                     `tfsdk:"id"` // needed by TF
+	ProjectId          types.String                     `tfsdk:"project_id"`
+	CredentialsGroupId types.String                     `tfsdk:"credentials_group_id"`
+	Region             types.String                     `tfsdk:"region"`
+	Items              []credentialsDataSourceItemModel `tfsdk:"items"`
+}
+
+// credentialsDataSourceItemModel maps credential schema data.
+type credentialsDataSourceItemModel struct {
+	CredentialId        types.String `tfsdk:"credential_id"`
+	ExpirationTimestamp types.String `tfsdk:"expiration_timestamp"`
+}
+
+// NewCredentialsDataSource is a helper function to simplify the provider implementation.
+func NewCredentialsDataSource() datasource.DataSource {
+	return &credentialsDataSource{}
+}
+
+// credentialsDataSource is the data source implementation.
+type credentialsDataSource struct {
+	client       *objectstorage.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (r *credentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_credentials"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *credentialsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *objectstorage.APIClient
+	var err error
+	if r.providerData.ObjectStorageCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "ObjectStorage credentials client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                 "ObjectStorage credentials data source schema. Lists the IDs and expiration timestamps of all credentials of a credentials group, e.g. for key inventory audits. Must have a `region` specified in the provider configuration.",
+		"id":                   "Terraform's internal data source identifier. It is structured as \"`project_id`,`credentials_group_id`\".",
+		"project_id":           "STACKIT Project ID to which the credentials group is associated.",
+		"credentials_group_id": "The credentials group ID.",
+		"region":               "The resource region. If not defined, the provider region is used.",
+		"items":                "The credentials of the credentials group.",
+		"credential_id":        "The credential ID.",
+		"expiration_timestamp": "Expiration timestamp of the credential, in RFC3339 format. Not set if the credential doesn't expire.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"credentials_group_id": schema.StringAttribute{
+				Description: descriptions["credentials_group_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: descriptions["items"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"credential_id": schema.StringAttribute{
+							Description: descriptions["credential_id"],
+							Computed:    true,
+						},
+						"expiration_timestamp": schema.StringAttribute{
+							Description: descriptions["expiration_timestamp"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *credentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model credentialsDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	credentialsGroupId := model.CredentialsGroupId.ValueString()
+	var region string
+	if utils.IsUndefined(model.Region) {
+		region = r.providerData.Region
+	} else {
+		region = model.Region.ValueString()
+	}
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "credentials_group_id", credentialsGroupId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	accessKeysResp, err := r.client.ListAccessKeys(ctx, projectId, region).CredentialsGroup(credentialsGroupId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credentials", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapCredentialsDataSourceFields(accessKeysResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credentials", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "ObjectStorage credentials read")
+}
+
+func mapCredentialsDataSourceFields(accessKeysResp *objectstorage.ListAccessKeysResponse, model *credentialsDataSourceModel, region string) error {
+	if accessKeysResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	idParts := []string{
+		model.ProjectId.ValueString(),
+		model.CredentialsGroupId.ValueString(),
+	}
+	model.Id = types.StringValue(
+		strings.Join(idParts, core.Separator),
+	)
+	model.Region = types.StringValue(region)
+	model.Items = []credentialsDataSourceItemModel{}
+	if accessKeysResp.AccessKeys == nil {
+		return nil
+	}
+	for _, accessKey := range *accessKeysResp.AccessKeys {
+		if accessKey.KeyId == nil {
+			return fmt.Errorf("credential id not present")
+		}
+
+		expirationTimestamp := types.StringNull()
+		if accessKey.Expires != nil {
+			// Harmonize the timestamp format
+			// Eg. "2027-01-02T03:04:05.000Z" = "2027-01-02T03:04:05Z"
+			expires, err := time.Parse(time.RFC3339, *accessKey.Expires)
+			if err != nil {
+				return fmt.Errorf("unable to parse expiration timestamp of credential %q: %w", *accessKey.KeyId, err)
+			}
+			expirationTimestamp = types.StringValue(expires.Format(time.RFC3339))
+		}
+
+		model.Items = append(model.Items, credentialsDataSourceItemModel{
+			CredentialId:        types.StringPointerValue(accessKey.KeyId),
+			ExpirationTimestamp: expirationTimestamp,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package objectstorage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/objectstorage"
+)
+
+func TestMapCredentialsDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *objectstorage.ListAccessKeysResponse
+		expected    credentialsDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_credentials",
+			&objectstorage.ListAccessKeysResponse{},
+			credentialsDataSourceModel{
+				Id:                 types.StringValue("pid,cgid"),
+				ProjectId:          types.StringValue("pid"),
+				CredentialsGroupId: types.StringValue("cgid"),
+				Region:             types.StringValue("eu01"),
+				Items:              []credentialsDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&objectstorage.ListAccessKeysResponse{
+				AccessKeys: &[]objectstorage.AccessKey{
+					{
+						KeyId:       utils.Ptr("cid"),
+						DisplayName: utils.Ptr("name"),
+						Expires:     utils.Ptr("2027-01-02T03:04:05.000Z"),
+					},
+					{
+						KeyId: utils.Ptr("cid-2"),
+					},
+				},
+			},
+			credentialsDataSourceModel{
+				Id:                 types.StringValue("pid,cgid"),
+				ProjectId:          types.StringValue("pid"),
+				CredentialsGroupId: types.StringValue("cgid"),
+				Region:             types.StringValue("eu01"),
+				Items: []credentialsDataSourceItemModel{
+					{
+						CredentialId:        types.StringValue("cid"),
+						ExpirationTimestamp: types.StringValue("2027-01-02T03:04:05Z"),
+					},
+					{
+						CredentialId:        types.StringValue("cid-2"),
+						ExpirationTimestamp: types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid_expiration_timestamp",
+			&objectstorage.ListAccessKeysResponse{
+				AccessKeys: &[]objectstorage.AccessKey{
+					{
+						KeyId:   utils.Ptr("cid"),
+						Expires: utils.Ptr("tomorrow"),
+					},
+				},
+			},
+			credentialsDataSourceModel{},
+			false,
+		},
+		{
+			"no_credential_id",
+			&objectstorage.ListAccessKeysResponse{
+				AccessKeys: &[]objectstorage.AccessKey{
+					{},
+				},
+			},
+			credentialsDataSourceModel{},
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			credentialsDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &credentialsDataSourceModel{
+				ProjectId:          types.StringValue("pid"),
+				CredentialsGroupId: types.StringValue("cgid"),
+			}
+			err := mapCredentialsDataSourceFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
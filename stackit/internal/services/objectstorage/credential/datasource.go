@@ -52,12 +52,12 @@ func (r *credentialDataSource) Configure(ctx context.Context, req datasource.Con
 	var apiClient *objectstorage.APIClient
 	var err error
 	if r.providerData.ObjectStorageCustomEndpoint != "" {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
 		)
 	} else {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 		)
 	}
@@ -76,10 +76,11 @@ func (r *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	descriptions := map[string]string{
 		"main":                 "ObjectStorage credential data source schema. Must have a `region` specified in the provider configuration.",
 		"id":                   "Terraform's internal resource identifier. It is structured as \"`project_id`,`credentials_group_id`,`credential_id`\".",
+		"import_id":            "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"credential_id":        "The credential ID.",
 		"credentials_group_id": "The credential group ID.",
 		"project_id":           "STACKIT Project ID to which the credential group is associated.",
-		"region":               "The resource region. Read-only attribute that reflects the provider region.",
+		"region":               "The resource region. If not defined, the provider region is used.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -89,6 +90,10 @@ func (r *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"credential_id": schema.StringAttribute{
 				Description: descriptions["credential_id"],
 				Required:    true,
@@ -116,7 +121,7 @@ func (r *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			},
 			"region": schema.StringAttribute{
 				// the region cannot be found automatically, so it has to be passed
-				Optional:    false,
+				Optional:    true,
 				Computed:    true,
 				Description: descriptions["region"],
 			},
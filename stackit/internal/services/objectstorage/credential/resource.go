@@ -35,6 +35,7 @@ var (
 
 type Model struct {
 	Id                  types.String `tfsdk:"id"` // needed by TF
+	ImportId            types.String `tfsdk:"import_id"`
 	CredentialId        types.String `tfsdk:"credential_id"`
 	CredentialsGroupId  types.String `tfsdk:"credentials_group_id"`
 	ProjectId           types.String `tfsdk:"project_id"`
@@ -66,6 +67,9 @@ func (r *credentialResource) ModifyPlan(ctx context.Context, req resource.Modify
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if r.providerData.EnableSensitiveAttributesAudit {
+		utils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
@@ -148,12 +152,12 @@ func (r *credentialResource) Configure(ctx context.Context, req resource.Configu
 	var apiClient *objectstorage.APIClient
 	var err error
 	if r.providerData.ObjectStorageCustomEndpoint != "" {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
 		)
 	} else {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 		)
 	}
@@ -172,11 +176,12 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	descriptions := map[string]string{
 		"main":                 "ObjectStorage credential resource schema. Must have a `region` specified in the provider configuration.",
 		"id":                   "Terraform's internal resource identifier. It is structured as \"`project_id`,`credentials_group_id`,`credential_id`\".",
+		"import_id":            "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"credential_id":        "The credential ID.",
 		"credentials_group_id": "The credential group ID.",
 		"project_id":           "STACKIT Project ID to which the credential group is associated.",
 		"expiration_timestamp": "Expiration timestamp, in RFC339 format without fractional seconds. Example: \"2025-01-01T00:00:00Z\". If not set, the credential never expires.",
-		"region":               "The resource region. Read-only attribute that reflects the provider region.",
+		"region":               "The resource region. If not defined, the provider region is used.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -189,6 +194,13 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"credential_id": schema.StringAttribute{
 				Description: descriptions["credential_id"],
 				Computed:    true,
@@ -246,7 +258,7 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"region": schema.StringAttribute{
-				Optional: false,
+				Optional: true,
 				// must be computed to allow for storing the override value from the provider
 				Computed:    true,
 				Description: descriptions["region"],
@@ -290,7 +302,7 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create new credential
 	credentialResp, err := r.client.CreateAccessKey(ctx, projectId, region).CredentialsGroup(credentialsGroupId).CreateAccessKeyPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating credential", err, req.Plan)
 		return
 	}
 	if credentialResp.KeyId == nil {
@@ -306,6 +318,18 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.SecretAccessKey.ValueString())
+
+	// The credential is not always listed right after its creation, wait until it can be read
+	// to avoid it being removed from the state by the next refresh
+	err = utils.ReadAfterWrite(ctx, func() (bool, error) {
+		readModel := model
+		return readCredentials(ctx, &readModel, region, r.client)
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Reading created credential: %v", err))
+		return
+	}
 
 	if !utils.IsUndefined(model.ExpirationTimestamp) {
 		var (
@@ -343,6 +367,7 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.SecretAccessKey.ValueString())
 
 	projectId := model.ProjectId.ValueString()
 	credentialsGroupId := model.CredentialsGroupId.ValueString()
@@ -419,6 +444,7 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.SecretAccessKey.ValueString())
 
 	projectId := model.ProjectId.ValueString()
 	credentialsGroupId := model.CredentialsGroupId.ValueString()
@@ -532,6 +558,7 @@ func mapFields(credentialResp *objectstorage.CreateAccessKeyResponse, model *Mod
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.CredentialId = types.StringValue(credentialId)
 	model.Name = types.StringPointerValue(credentialResp.DisplayName)
 	model.AccessKey = types.StringPointerValue(credentialResp.AccessKey)
@@ -576,6 +603,7 @@ func readCredentials(ctx context.Context, model *Model, region string, client *o
 		model.Id = types.StringValue(
 			strings.Join(idParts, core.Separator),
 		)
+		model.ImportId = model.Id
 		model.Name = types.StringPointerValue(credential.DisplayName)
 
 		if credential.Expires == nil {
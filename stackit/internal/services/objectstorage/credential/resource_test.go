@@ -44,6 +44,7 @@ func TestMapFields(t *testing.T) {
 			&objectstorage.CreateAccessKeyResponse{},
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -65,6 +66,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -85,6 +87,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -103,6 +106,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -163,6 +167,7 @@ func TestEnableProject(t *testing.T) {
 			"default_values",
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -178,6 +183,7 @@ func TestEnableProject(t *testing.T) {
 			"error_response",
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -239,6 +245,7 @@ func TestReadCredentials(t *testing.T) {
 			},
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -275,6 +282,7 @@ func TestReadCredentials(t *testing.T) {
 			},
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
@@ -311,6 +319,7 @@ func TestReadCredentials(t *testing.T) {
 			},
 			Model{
 				Id:                  types.StringValue("pid,cgid,cid"),
+				ImportId:            types.StringValue("pid,cgid,cid"),
 				ProjectId:           types.StringValue("pid"),
 				CredentialsGroupId:  types.StringValue("cgid"),
 				CredentialId:        types.StringValue("cid"),
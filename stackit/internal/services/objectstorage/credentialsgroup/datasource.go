@@ -55,12 +55,12 @@ func (r *credentialsGroupDataSource) Configure(ctx context.Context, req datasour
 	var apiClient *objectstorage.APIClient
 	var err error
 	if r.providerData.ObjectStorageCustomEndpoint != "" {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
 		)
 	} else {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 		)
 	}
@@ -79,11 +79,12 @@ func (r *credentialsGroupDataSource) Schema(_ context.Context, _ datasource.Sche
 	descriptions := map[string]string{
 		"main":                 "ObjectStorage credentials group data source schema. Must have a `region` specified in the provider configuration.",
 		"id":                   "Terraform's internal data source identifier. It is structured as \"`project_id`,`credentials_group_id`\".",
+		"import_id":            "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"credentials_group_id": "The credentials group ID.",
 		"name":                 "The credentials group's display name.",
 		"project_id":           "Object Storage Project ID to which the credentials group is associated.",
 		"urn":                  "Credentials group uniform resource name (URN)",
-		"region":               "The resource region. Read-only attribute that reflects the provider region.",
+		"region":               "The resource region. If not defined, the provider region is used.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -93,6 +94,10 @@ func (r *credentialsGroupDataSource) Schema(_ context.Context, _ datasource.Sche
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"credentials_group_id": schema.StringAttribute{
 				Description: descriptions["credentials_group_id"],
 				Optional:    true,
@@ -117,7 +122,7 @@ func (r *credentialsGroupDataSource) Schema(_ context.Context, _ datasource.Sche
 			},
 			"region": schema.StringAttribute{
 				// the region cannot be found automatically, so it has to be passed
-				Optional:    false,
+				Optional:    true,
 				Computed:    true,
 				Description: descriptions["region"],
 			},
@@ -34,6 +34,7 @@ var (
 
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
+	ImportId           types.String `tfsdk:"import_id"`
 	CredentialsGroupId types.String `tfsdk:"credentials_group_id"`
 	Name               types.String `tfsdk:"name"`
 	ProjectId          types.String `tfsdk:"project_id"`
@@ -104,12 +105,12 @@ func (r *credentialsGroupResource) Configure(ctx context.Context, req resource.C
 	var apiClient *objectstorage.APIClient
 	var err error
 	if r.providerData.ObjectStorageCustomEndpoint != "" {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.ObjectStorageCustomEndpoint),
 		)
 	} else {
-		apiClient, err = objectstorage.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, objectstorage.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 		)
 	}
@@ -128,11 +129,12 @@ func (r *credentialsGroupResource) Schema(_ context.Context, _ resource.SchemaRe
 	descriptions := map[string]string{
 		"main":                 "ObjectStorage credentials group resource schema. Must have a `region` specified in the provider configuration. If you are creating `credentialsgroup` and `bucket` resources simultaneously, please include the `depends_on` field so that they are created sequentially. This prevents errors from concurrent calls to the service enablement that is done in the background.",
 		"id":                   "Terraform's internal data source identifier. It is structured as \"`project_id`,`credentials_group_id`\".",
+		"import_id":            "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"credentials_group_id": "The credentials group ID",
 		"name":                 "The credentials group's display name.",
 		"project_id":           "Project ID to which the credentials group is associated.",
 		"urn":                  "Credentials group uniform resource name (URN)",
-		"region":               "The resource region. Read-only attribute that reflects the provider region.",
+		"region":               "The resource region. If not defined, the provider region is used.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -145,6 +147,13 @@ func (r *credentialsGroupResource) Schema(_ context.Context, _ resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Description: descriptions["name"],
 				Required:    true,
@@ -174,7 +183,7 @@ func (r *credentialsGroupResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:    true,
 			},
 			"region": schema.StringAttribute{
-				Optional: false,
+				Optional: true,
 				// must be computed to allow for storing the override value from the provider
 				Computed:    true,
 				Description: descriptions["region"],
@@ -216,7 +225,7 @@ func (r *credentialsGroupResource) Create(ctx context.Context, req resource.Crea
 	// Create new credentials group
 	got, err := r.client.CreateCredentialsGroup(ctx, projectId, region).CreateCredentialsGroupPayload(createCredentialsGroupPayload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credentials group", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating credentials group", err, req.Plan)
 		return
 	}
 
@@ -359,6 +368,7 @@ func mapCredentialsGroup(credentialsGroup objectstorage.CredentialsGroup, model
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.CredentialsGroupId = types.StringValue(credentialsGroupId)
 	model.URN = types.StringPointerValue(credentialsGroup.Urn)
 	model.Name = types.StringPointerValue(credentialsGroup.DisplayName)
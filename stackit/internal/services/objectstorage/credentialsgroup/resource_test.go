@@ -48,6 +48,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,cid"),
+				ImportId:           types.StringValue("pid,cid"),
 				Name:               types.StringNull(),
 				ProjectId:          types.StringValue("pid"),
 				CredentialsGroupId: types.StringValue("cid"),
@@ -66,6 +67,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,cid"),
+				ImportId:           types.StringValue("pid,cid"),
 				Name:               types.StringValue("name"),
 				ProjectId:          types.StringValue("pid"),
 				CredentialsGroupId: types.StringValue("cid"),
@@ -84,6 +86,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,cid"),
+				ImportId:           types.StringValue("pid,cid"),
 				Name:               types.StringValue(""),
 				ProjectId:          types.StringValue("pid"),
 				CredentialsGroupId: types.StringValue("cid"),
@@ -184,6 +187,7 @@ func TestReadCredentialsGroups(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,cid"),
+				ImportId:           types.StringValue("pid,cid"),
 				Name:               types.StringNull(),
 				ProjectId:          types.StringValue("pid"),
 				CredentialsGroupId: types.StringValue("cid"),
@@ -211,6 +215,7 @@ func TestReadCredentialsGroups(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,cid"),
+				ImportId:           types.StringValue("pid,cid"),
 				Name:               types.StringValue("name"),
 				ProjectId:          types.StringValue("pid"),
 				CredentialsGroupId: types.StringValue("cid"),
@@ -0,0 +1,316 @@
+package objectstorage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &presignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &presignedURLEphemeralResource{}
+)
+
+const (
+	defaultMethod    = "GET"
+	defaultExpiresIn = 3600
+	// maxExpiresIn is the longest validity of a pre-signed URL supported by S3 (7 days)
+	maxExpiresIn = 7 * 24 * 3600
+
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+)
+
+type EphemeralModel struct {
+	BucketName      types.String `tfsdk:"bucket_name"`
+	ObjectKey       types.String `tfsdk:"object_key"`
+	AccessKeyId     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Method          types.String `tfsdk:"method"`
+	ExpiresIn       types.Int64  `tfsdk:"expires_in"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Region          types.String `tfsdk:"region"`
+	URL             types.String `tfsdk:"url"`
+	Expiration      types.String `tfsdk:"expiration"`
+}
+
+// NewPresignedURLEphemeralResource is a helper function to simplify the provider implementation.
+func NewPresignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &presignedURLEphemeralResource{}
+}
+
+// presignedURLEphemeralResource is the ephemeral resource implementation.
+type presignedURLEphemeralResource struct {
+	region string
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *presignedURLEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_presigned_url"
+}
+
+// Configure sets the region of the provider, which is used to sign the URLs if no region is set.
+func (r *presignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring ephemeral resource", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	r.region = providerData.Region
+	tflog.Info(ctx, "Object Storage pre-signed URL configured")
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *presignedURLEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	methodOptions := []string{"GET", "PUT"}
+
+	descriptions := map[string]string{
+		"main":              "Object Storage pre-signed URL schema. Generates a time-limited URL to download or upload an object with the given credentials, e.g. to let a server fetch private artifacts while bootstrapping, without handing it the credentials. The URL is signed locally, no API is called. Requires Terraform 1.10 or later.",
+		"bucket_name":       "Name of the bucket.",
+		"object_key":        "Key of the object, e.g. `artifacts/app.tar.gz`.",
+		"access_key_id":     "Access key ID of an Object Storage credential with access to the bucket.",
+		"secret_access_key": "Secret access key of the credential.",
+		"method":            "HTTP method which the URL can be used with. " + utils.SupportedValuesDocumentation(methodOptions) + fmt.Sprintf(" Defaults to `%s`.", defaultMethod),
+		"expires_in":        fmt.Sprintf("Validity of the URL in seconds. Defaults to `%d` (1 hour), the maximum is `%d` (7 days).", defaultExpiresIn, maxExpiresIn),
+		"endpoint":          "S3 compatible endpoint of the region of the bucket. Defaults to the endpoint of the `region`, e.g. `https://object.storage.eu01.onstackit.cloud`.",
+		"region":            "The region of the bucket, which the URL is signed for. If not defined, the provider region is used.",
+		"url":               "The pre-signed URL of the object.",
+		"expiration":        "Expiration time of the URL in RFC3339 format.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Description: descriptions["bucket_name"],
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"object_key": schema.StringAttribute{
+				Description: descriptions["object_key"],
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_key_id": schema.StringAttribute{
+				Description: descriptions["access_key_id"],
+				Required:    true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Description: descriptions["secret_access_key"],
+				Required:    true,
+				Sensitive:   true,
+			},
+			"method": schema.StringAttribute{
+				Description: descriptions["method"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(methodOptions...),
+				},
+			},
+			"expires_in": schema.Int64Attribute{
+				Description: descriptions["expires_in"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxExpiresIn),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Description: descriptions["endpoint"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: descriptions["region"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expiration": schema.StringAttribute{
+				Description: descriptions["expiration"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Open signs the URL and sets it in the result.
+func (r *presignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) { // nolint:gocritic // function signature required by Terraform
+	var model EphemeralModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = core.MaskSensitiveValues(ctx, model.SecretAccessKey.ValueString())
+	ctx = tflog.SetField(ctx, "bucket_name", model.BucketName.ValueString())
+	ctx = tflog.SetField(ctx, "object_key", model.ObjectKey.ValueString())
+
+	if model.Region.IsNull() || model.Region.IsUnknown() {
+		if r.region == "" {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error generating pre-signed URL", "Either region or the provider region must be set")
+			return
+		}
+		model.Region = types.StringValue(r.region)
+	}
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "region", region)
+
+	if model.Method.IsNull() || model.Method.IsUnknown() {
+		model.Method = types.StringValue(defaultMethod)
+	}
+	if model.ExpiresIn.IsNull() || model.ExpiresIn.IsUnknown() {
+		model.ExpiresIn = types.Int64Value(defaultExpiresIn)
+	}
+	if model.Endpoint.IsNull() || model.Endpoint.IsUnknown() {
+		model.Endpoint = types.StringValue(defaultEndpoint(region))
+	}
+
+	objectURL, err := toObjectURL(model.Endpoint.ValueString(), model.BucketName.ValueString(), model.ObjectKey.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error generating pre-signed URL", fmt.Sprintf("Building object URL: %v", err))
+		return
+	}
+
+	now := time.Now().UTC()
+	expiresIn := time.Duration(model.ExpiresIn.ValueInt64()) * time.Second
+	model.URL = types.StringValue(presignURL(model.Method.ValueString(), objectURL, region, model.AccessKeyId.ValueString(), model.SecretAccessKey.ValueString(), expiresIn, now))
+	model.Expiration = types.StringValue(now.Add(expiresIn).Format(time.RFC3339))
+
+	diags = resp.Result.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Object Storage pre-signed URL generated")
+}
+
+// defaultEndpoint returns the S3 compatible endpoint of the region, e.g. "https://object.storage.eu01.onstackit.cloud".
+func defaultEndpoint(region string) string {
+	return fmt.Sprintf("https://object.storage.%s.onstackit.cloud", region)
+}
+
+// toObjectURL returns the URL of the object in path style, e.g. "https://object.storage.eu01.onstackit.cloud/bucket/key".
+func toObjectURL(endpoint, bucketName, objectKey string) (*url.URL, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("endpoint %q must contain a scheme and a host", endpoint)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucketName + "/" + strings.TrimPrefix(objectKey, "/")
+	return u, nil
+}
+
+// presignURL signs the URL of the object with the AWS Signature Version 4 as query parameters,
+// see https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+func presignURL(method string, objectURL *url.URL, region, accessKeyId, secretAccessKey string, expiresIn time.Duration, now time.Time) string {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := strings.Join([]string{date, region, signingService, "aws4_request"}, "/")
+
+	query := map[string]string{
+		"X-Amz-Algorithm":     signingAlgorithm,
+		"X-Amz-Credential":    accessKeyId + "/" + scope,
+		"X-Amz-Date":          amzDate,
+		"X-Amz-Expires":       fmt.Sprintf("%d", int64(expiresIn.Seconds())),
+		"X-Amz-SignedHeaders": "host",
+	}
+	canonicalURI := uriEncode(objectURL.Path, false)
+	canonicalQuery := canonicalQueryString(query)
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalURI,
+		canonicalQuery,
+		"host:" + objectURL.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, signingService)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return fmt.Sprintf("%s://%s%s?%s&X-Amz-Signature=%s", objectURL.Scheme, objectURL.Host, canonicalURI, canonicalQuery, signature)
+}
+
+func canonicalQueryString(query map[string]string) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, uriEncode(key, true)+"="+uriEncode(query[key], true))
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode encodes all characters except the unreserved ones, as required by the AWS Signature Version 4.
+// Slashes are only encoded if encodeSlash is set, e.g. for query parameters.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
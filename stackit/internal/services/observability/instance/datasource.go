@@ -55,12 +55,12 @@ func (d *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	}
 
 	if providerData.ObservabilityCustomEndpoint != "" {
-		apiClient, err = observability.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, observability.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ObservabilityCustomEndpoint),
 		)
 	} else {
-		apiClient, err = observability.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, observability.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -82,6 +82,10 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`,`instance_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the instance is associated.",
 				Required:    true,
@@ -130,6 +134,10 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Specifies Observability instance dashboard URL.",
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: "Status of the instance, e.g. `CREATE_SUCCEEDED`.",
+				Computed:    true,
+			},
 			"is_updatable": schema.BoolAttribute{
 				Description: "Specifies if the instance can be updated.",
 				Computed:    true,
@@ -32,6 +32,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	argusInstanceResource "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/argus/instance"
+	internalUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -44,11 +45,13 @@ var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 	_ resource.ResourceWithMoveState   = &instanceResource{}
 )
 
 type Model struct {
 	Id                                 types.String `tfsdk:"id"` // needed by TF
+	ImportId                           types.String `tfsdk:"import_id"`
 	ProjectId                          types.String `tfsdk:"project_id"`
 	InstanceId                         types.String `tfsdk:"instance_id"`
 	Name                               types.String `tfsdk:"name"`
@@ -57,6 +60,7 @@ type Model struct {
 	Parameters                         types.Map    `tfsdk:"parameters"`
 	DashboardURL                       types.String `tfsdk:"dashboard_url"`
 	IsUpdatable                        types.Bool   `tfsdk:"is_updatable"`
+	Status                             types.String `tfsdk:"status"`
 	GrafanaURL                         types.String `tfsdk:"grafana_url"`
 	GrafanaPublicReadAccess            types.Bool   `tfsdk:"grafana_public_read_access"`
 	GrafanaInitialAdminPassword        types.String `tfsdk:"grafana_initial_admin_password"`
@@ -333,7 +337,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *observability.APIClient
+	client       *observability.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -348,7 +353,8 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -356,15 +362,15 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 
 	var apiClient *observability.APIClient
 	var err error
-	if providerData.ObservabilityCustomEndpoint != "" {
-		apiClient, err = observability.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.ObservabilityCustomEndpoint),
+	if r.providerData.ObservabilityCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, observability.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ObservabilityCustomEndpoint),
 		)
 	} else {
-		apiClient, err = observability.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, observability.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -400,6 +406,7 @@ func (r *instanceResource) MoveState(_ context.Context) []resource.StateMover {
 
 				targetStateData := Model{
 					Id:                                 sourceStateData.Id,
+					ImportId:                           sourceStateData.ImportId,
 					ProjectId:                          sourceStateData.ProjectId,
 					InstanceId:                         sourceStateData.InstanceId,
 					Name:                               sourceStateData.Name,
@@ -408,6 +415,7 @@ func (r *instanceResource) MoveState(_ context.Context) []resource.StateMover {
 					Parameters:                         sourceStateData.Parameters,
 					DashboardURL:                       sourceStateData.DashboardURL,
 					IsUpdatable:                        sourceStateData.IsUpdatable,
+					Status:                             sourceStateData.Status,
 					GrafanaURL:                         sourceStateData.GrafanaURL,
 					GrafanaPublicReadAccess:            sourceStateData.GrafanaPublicReadAccess,
 					GrafanaInitialAdminPassword:        sourceStateData.GrafanaInitialAdminPassword,
@@ -435,6 +443,16 @@ func (r *instanceResource) MoveState(_ context.Context) []resource.StateMover {
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled,
+// and to validate the project when planning the creation of the instance, if enabled.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		internalUtils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+	internalUtils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -447,6 +465,13 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the instance is associated.",
 				Required:    true,
@@ -508,6 +533,10 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: "Status of the instance, e.g. `CREATE_SUCCEEDED`.",
+				Computed:    true,
+			},
 			"is_updatable": schema.BoolAttribute{
 				Description: "Specifies if the instance can be updated.",
 				Computed:    true,
@@ -881,12 +910,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*createPayload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating instance", err, req.Plan)
 		return
 	}
 	instanceId := createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, *instanceId, projectId).WaitWithContext(ctx)
+	waitResp, err := internalUtils.Wait(ctx, wait.CreateInstanceWaitHandler(ctx, r.client, *instanceId, projectId), "Observability instance creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -1175,10 +1204,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update existing instance
 	_, err = r.client.UpdateInstance(ctx, instanceId, projectId).UpdateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating instance", err, req.Plan)
 		return
 	}
-	waitResp, err := wait.UpdateInstanceWaitHandler(ctx, r.client, instanceId, projectId).WaitWithContext(ctx)
+	waitResp, err := internalUtils.Wait(ctx, wait.UpdateInstanceWaitHandler(ctx, r.client, instanceId, projectId), "Observability instance update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
@@ -1327,7 +1356,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, instanceId, projectId).WaitWithContext(ctx)
+	_, err = internalUtils.Wait(ctx, wait.DeleteInstanceWaitHandler(ctx, r.client, instanceId, projectId), "Observability instance deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -1377,6 +1406,7 @@ func mapFields(ctx context.Context, r *observability.GetInstanceResponse, model
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.InstanceId = types.StringValue(instanceId)
 	model.PlanName = types.StringPointerValue(r.PlanName)
 	model.PlanId = types.StringPointerValue(r.PlanId)
@@ -1398,6 +1428,7 @@ func mapFields(ctx context.Context, r *observability.GetInstanceResponse, model
 	}
 
 	model.IsUpdatable = types.BoolPointerValue(r.IsUpdatable)
+	model.Status = types.StringPointerValue(r.Status)
 	model.DashboardURL = types.StringPointerValue(r.DashboardUrl)
 	if r.Instance != nil {
 		i := *r.Instance
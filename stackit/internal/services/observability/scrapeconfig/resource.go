@@ -3,6 +3,7 @@ package observability
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 	"strings"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	argusScrapeConfigResource "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/argus/scrapeconfig"
+	internalUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
@@ -42,6 +44,8 @@ const (
 	DefaultScrapeTimeout            = "2m"
 	DefaultSampleLimit              = int64(5000)
 	DefaultSAML2EnableURLParameters = true
+
+	scrapeConfigUpdateDelay = 15 * time.Second
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -49,11 +53,13 @@ var (
 	_ resource.Resource                = &scrapeConfigResource{}
 	_ resource.ResourceWithConfigure   = &scrapeConfigResource{}
 	_ resource.ResourceWithImportState = &scrapeConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &scrapeConfigResource{}
 	_ resource.ResourceWithMoveState   = &scrapeConfigResource{}
 )
 
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
+	ImportId       types.String `tfsdk:"import_id"`
 	ProjectId      types.String `tfsdk:"project_id"`
 	InstanceId     types.String `tfsdk:"instance_id"`
 	Name           types.String `tfsdk:"name"`
@@ -108,7 +114,8 @@ func NewScrapeConfigResource() resource.Resource {
 
 // scrapeConfigResource is the resource implementation.
 type scrapeConfigResource struct {
-	client *observability.APIClient
+	client       *observability.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -123,7 +130,8 @@ func (r *scrapeConfigResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -131,15 +139,15 @@ func (r *scrapeConfigResource) Configure(ctx context.Context, req resource.Confi
 
 	var apiClient *observability.APIClient
 	var err error
-	if providerData.ObservabilityCustomEndpoint != "" {
-		apiClient, err = observability.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.ObservabilityCustomEndpoint),
+	if r.providerData.ObservabilityCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, observability.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ObservabilityCustomEndpoint),
 		)
 	} else {
-		apiClient, err = observability.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, observability.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -173,6 +181,7 @@ func (r *scrapeConfigResource) MoveState(_ context.Context) []resource.StateMove
 
 				targetStateData := Model{
 					Id:             sourceStateData.Id,
+					ImportId:       sourceStateData.ImportId,
 					ProjectId:      sourceStateData.ProjectId,
 					InstanceId:     sourceStateData.InstanceId,
 					Name:           sourceStateData.Name,
@@ -192,161 +201,192 @@ func (r *scrapeConfigResource) MoveState(_ context.Context) []resource.StateMove
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled.
+func (r *scrapeConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		internalUtils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *scrapeConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Observability scrape config resource schema. Must have a `region` specified in the provider configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`name`\".",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`name`\".",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"project_id": schema.StringAttribute{
-				Description: "STACKIT project ID to which the scraping job is associated.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.UUID(),
-					validate.NoSeparator(),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+		},
+		"import_id": schema.StringAttribute{
+			Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"instance_id": schema.StringAttribute{
-				Description: "Observability instance ID to which the scraping job is associated.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.UUID(),
-					validate.NoSeparator(),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+		},
+		"project_id": schema.StringAttribute{
+			Description: "STACKIT project ID to which the scraping job is associated.",
+			Required:    true,
+			Validators: []validator.String{
+				validate.UUID(),
+				validate.NoSeparator(),
 			},
-			"name": schema.StringAttribute{
-				Description: "Specifies the name of the scraping job.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.NoSeparator(),
-					stringvalidator.LengthBetween(1, 200),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"metrics_path": schema.StringAttribute{
-				Description: "Specifies the job scraping url path. E.g. `/metrics`.",
-				Required:    true,
-				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 200),
-				},
+		},
+		"instance_id": schema.StringAttribute{
+			Description: "Observability instance ID to which the scraping job is associated.",
+			Required:    true,
+			Validators: []validator.String{
+				validate.UUID(),
+				validate.NoSeparator(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Specifies the name of the scraping job.",
+			Required:    true,
+			Validators: []validator.String{
+				validate.NoSeparator(),
+				stringvalidator.LengthBetween(1, 200),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
+		},
+	}
+	maps.Copy(attributes, jobAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Observability scrape config resource schema. Must have a `region` specified in the provider configuration.",
+		Attributes:  attributes,
+	}
+}
+
+// waitForScrapeConfigUpdate gives the instance time to apply updated scrape configs. The API has no status for
+// updates and returns the new values right away, so there is nothing a wait handler could poll for. The delay is
+// the one the scrape config resource has always used; it ends early if the context is done.
+func waitForScrapeConfigUpdate(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(scrapeConfigUpdateDelay):
+	}
+}
 
-			"scheme": schema.StringAttribute{
-				Description: "Specifies the http scheme. Defaults to `https`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     stringdefault.StaticString(DefaultScheme),
+// jobAttributes returns the schema attributes of a scraping job, which are shared with the scrape configs resource.
+func jobAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"metrics_path": schema.StringAttribute{
+			Description: "Specifies the job scraping url path. E.g. `/metrics`.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 200),
 			},
-			"scrape_interval": schema.StringAttribute{
-				Description: "Specifies the scrape interval as duration string. Defaults to `5m`.",
-				Optional:    true,
-				Computed:    true,
-				Validators: []validator.String{
-					stringvalidator.LengthBetween(2, 8),
-				},
-				Default: stringdefault.StaticString(DefaultScrapeInterval),
+		},
+		"scheme": schema.StringAttribute{
+			Description: "Specifies the http scheme. Defaults to `https`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(DefaultScheme),
+		},
+		"scrape_interval": schema.StringAttribute{
+			Description: "Specifies the scrape interval as duration string. Defaults to `5m`.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 8),
 			},
-			"scrape_timeout": schema.StringAttribute{
-				Description: "Specifies the scrape timeout as duration string. Defaults to `2m`.",
-				Optional:    true,
-				Computed:    true,
-				Validators: []validator.String{
-					stringvalidator.LengthBetween(2, 8),
-				},
-				Default: stringdefault.StaticString(DefaultScrapeTimeout),
+			Default: stringdefault.StaticString(DefaultScrapeInterval),
+		},
+		"scrape_timeout": schema.StringAttribute{
+			Description: "Specifies the scrape timeout as duration string. Defaults to `2m`.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(2, 8),
 			},
-			"sample_limit": schema.Int64Attribute{
-				Description: "Specifies the scrape sample limit. Upper limit depends on the service plan. Defaults to `5000`.",
-				Optional:    true,
-				Computed:    true,
-				Validators: []validator.Int64{
-					int64validator.Between(1, 3000000),
-				},
-				Default: int64default.StaticInt64(DefaultSampleLimit),
+			Default: stringdefault.StaticString(DefaultScrapeTimeout),
+		},
+		"sample_limit": schema.Int64Attribute{
+			Description: "Specifies the scrape sample limit. Upper limit depends on the service plan. Defaults to `5000`.",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 3000000),
 			},
-			"saml2": schema.SingleNestedAttribute{
-				Description: "A SAML2 configuration block.",
-				Optional:    true,
-				Computed:    true,
-				Default: objectdefault.StaticValue(
-					types.ObjectValueMust(
-						map[string]attr.Type{
-							"enable_url_parameters": types.BoolType,
-						},
-						map[string]attr.Value{
-							"enable_url_parameters": types.BoolValue(DefaultSAML2EnableURLParameters),
-						},
-					),
-				),
-				Attributes: map[string]schema.Attribute{
-					"enable_url_parameters": schema.BoolAttribute{
-						Description: "Specifies if URL parameters are enabled. Defaults to `true`",
-						Optional:    true,
-						Computed:    true,
-						Default:     booldefault.StaticBool(DefaultSAML2EnableURLParameters),
+			Default: int64default.StaticInt64(DefaultSampleLimit),
+		},
+		"saml2": schema.SingleNestedAttribute{
+			Description: "A SAML2 configuration block.",
+			Optional:    true,
+			Computed:    true,
+			Default: objectdefault.StaticValue(
+				types.ObjectValueMust(
+					saml2Types,
+					map[string]attr.Value{
+						"enable_url_parameters": types.BoolValue(DefaultSAML2EnableURLParameters),
 					},
+				),
+			),
+			Attributes: map[string]schema.Attribute{
+				"enable_url_parameters": schema.BoolAttribute{
+					Description: "Specifies if URL parameters are enabled. Defaults to `true`",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(DefaultSAML2EnableURLParameters),
 				},
 			},
-			"basic_auth": schema.SingleNestedAttribute{
-				Description: "A basic authentication block.",
-				Optional:    true,
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"username": schema.StringAttribute{
-						Description: "Specifies basic auth username.",
-						Required:    true,
-						Validators: []validator.String{
-							stringvalidator.LengthBetween(1, 200),
-						},
+		},
+		"basic_auth": schema.SingleNestedAttribute{
+			Description: "A basic authentication block.",
+			Optional:    true,
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"username": schema.StringAttribute{
+					Description: "Specifies basic auth username.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 200),
 					},
-					"password": schema.StringAttribute{
-						Description: "Specifies basic auth password.",
-						Required:    true,
-						Sensitive:   true,
-						Validators: []validator.String{
-							stringvalidator.LengthBetween(1, 200),
-						},
+				},
+				"password": schema.StringAttribute{
+					Description: "Specifies basic auth password.",
+					Required:    true,
+					Sensitive:   true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 200),
 					},
 				},
 			},
-			"targets": schema.ListNestedAttribute{
-				Description: "The targets list (specified by the static config).",
-				Required:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"urls": schema.ListAttribute{
-							Description: "Specifies target URLs.",
-							Required:    true,
-							ElementType: types.StringType,
-							Validators: []validator.List{
-								listvalidator.ValueStringsAre(
-									stringvalidator.LengthBetween(1, 500),
-								),
-							},
+		},
+		"targets": schema.ListNestedAttribute{
+			Description: "The targets list (specified by the static config).",
+			Required:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"urls": schema.ListAttribute{
+						Description: "Specifies target URLs.",
+						Required:    true,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(
+								stringvalidator.LengthBetween(1, 500),
+							),
 						},
-						"labels": schema.MapAttribute{
-							Description: "Specifies labels.",
-							Optional:    true,
-							ElementType: types.StringType,
-							Validators: []validator.Map{
-								mapvalidator.SizeAtMost(10),
-								mapvalidator.ValueStringsAre(stringvalidator.LengthBetween(0, 200)),
-								mapvalidator.KeysAre(stringvalidator.LengthBetween(0, 200)),
-							},
+					},
+					"labels": schema.MapAttribute{
+						Description: "Specifies labels.",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtMost(10),
+							mapvalidator.ValueStringsAre(stringvalidator.LengthBetween(0, 200)),
+							mapvalidator.KeysAre(stringvalidator.LengthBetween(0, 200)),
 						},
 					},
 				},
@@ -369,45 +409,24 @@ func (r *scrapeConfigResource) Create(ctx context.Context, req resource.CreateRe
 	instanceId := model.InstanceId.ValueString()
 	scName := model.Name.ValueString()
 
-	saml2Model := saml2Model{}
-	if !model.SAML2.IsNull() && !model.SAML2.IsUnknown() {
-		diags = model.SAML2.As(ctx, &saml2Model, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	basicAuthModel := basicAuthModel{}
-	if !model.BasicAuth.IsNull() && !model.BasicAuth.IsUnknown() {
-		diags = model.BasicAuth.As(ctx, &basicAuthModel, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	targetsModel := []targetModel{}
-	if !model.Targets.IsNull() && !model.Targets.IsUnknown() {
-		diags = model.Targets.ElementsAs(ctx, &targetsModel, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	saml2Model, basicAuthModel, targetsModel, err := jobPayloadInputs(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Creating API payload: %v", err))
+		return
 	}
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(ctx, &model, &saml2Model, &basicAuthModel, targetsModel)
+	payload, err := toCreatePayload(ctx, &model, saml2Model, basicAuthModel, targetsModel)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
 	_, err = r.client.CreateScrapeConfig(ctx, instanceId, projectId).CreateScrapeConfigPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating scrape config", err, req.Plan)
 		return
 	}
-	_, err = wait.CreateScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).WaitWithContext(ctx)
+	_, err = internalUtils.Wait(ctx, wait.CreateScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId), "Observability scrape config creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Scrape config creation waiting: %v", err))
 		return
@@ -482,46 +501,24 @@ func (r *scrapeConfigResource) Update(ctx context.Context, req resource.UpdateRe
 	instanceId := model.InstanceId.ValueString()
 	scName := model.Name.ValueString()
 
-	saml2Model := saml2Model{}
-	if !model.SAML2.IsNull() && !model.SAML2.IsUnknown() {
-		diags = model.SAML2.As(ctx, &saml2Model, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	basicAuthModel := basicAuthModel{}
-	if !model.BasicAuth.IsNull() && !model.BasicAuth.IsUnknown() {
-		diags = model.BasicAuth.As(ctx, &basicAuthModel, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	targetsModel := []targetModel{}
-	if !model.Targets.IsNull() && !model.Targets.IsUnknown() {
-		diags = model.Targets.ElementsAs(ctx, &targetsModel, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	saml2Model, basicAuthModel, targetsModel, err := jobPayloadInputs(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape config", fmt.Sprintf("Creating API payload: %v", err))
+		return
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(ctx, &model, &saml2Model, &basicAuthModel, targetsModel)
+	payload, err := toUpdatePayload(ctx, &model, saml2Model, basicAuthModel, targetsModel)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape config", fmt.Sprintf("Creating API payload: %v", err))
 		return
 	}
 	_, err = r.client.UpdateScrapeConfig(ctx, instanceId, scName, projectId).UpdateScrapeConfigPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape config", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating scrape config", err, req.Plan)
 		return
 	}
-	// We do not have an update status provided by the observability scrape config api, so we cannot use a waiter here, hence a simple sleep is used.
-	time.Sleep(15 * time.Second)
+	waitForScrapeConfigUpdate(ctx)
 
 	// Fetch updated ScrapeConfig
 	scResp, err := r.client.GetScrapeConfig(ctx, instanceId, scName, projectId).Execute()
@@ -562,7 +559,7 @@ func (r *scrapeConfigResource) Delete(ctx context.Context, req resource.DeleteRe
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape config", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).WaitWithContext(ctx)
+	_, err = internalUtils.Wait(ctx, wait.DeleteScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId), "Observability scrape config deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape config", fmt.Sprintf("Scrape config deletion waiting: %v", err))
 		return
@@ -590,6 +587,34 @@ func (r *scrapeConfigResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Info(ctx, "Observability scrape config state imported")
 }
 
+// jobPayloadInputs extracts the nested models needed to build the payload of a single scrape config
+func jobPayloadInputs(ctx context.Context, model *Model) (*saml2Model, *basicAuthModel, []targetModel, error) {
+	saml2 := saml2Model{}
+	if !model.SAML2.IsNull() && !model.SAML2.IsUnknown() {
+		diags := model.SAML2.As(ctx, &saml2, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, nil, nil, fmt.Errorf("mapping saml2: %w", core.DiagsToError(diags))
+		}
+	}
+
+	basicAuth := basicAuthModel{}
+	if !model.BasicAuth.IsNull() && !model.BasicAuth.IsUnknown() {
+		diags := model.BasicAuth.As(ctx, &basicAuth, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, nil, nil, fmt.Errorf("mapping basic auth: %w", core.DiagsToError(diags))
+		}
+	}
+
+	targets := []targetModel{}
+	if !model.Targets.IsNull() && !model.Targets.IsUnknown() {
+		diags := model.Targets.ElementsAs(ctx, &targets, false)
+		if diags.HasError() {
+			return nil, nil, nil, fmt.Errorf("mapping targets: %w", core.DiagsToError(diags))
+		}
+	}
+	return &saml2, &basicAuth, targets, nil
+}
+
 func mapFields(ctx context.Context, sc *observability.Job, model *Model) error {
 	if sc == nil {
 		return fmt.Errorf("response input is nil")
@@ -615,6 +640,7 @@ func mapFields(ctx context.Context, sc *observability.Job, model *Model) error {
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.Name = types.StringValue(scName)
 
 	model.MetricsPath = types.StringPointerValue(sc.MetricsPath)
@@ -25,6 +25,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:             types.StringValue("pid,iid,name"),
+				ImportId:       types.StringValue("pid,iid,name"),
 				ProjectId:      types.StringValue("pid"),
 				InstanceId:     types.StringValue("iid"),
 				Name:           types.StringValue("name"),
@@ -69,6 +70,7 @@ func TestMapFields(t *testing.T) {
 			},
 			expected: Model{
 				Id:             types.StringValue("pid,iid,name"),
+				ImportId:       types.StringValue("pid,iid,name"),
 				ProjectId:      types.StringValue("pid"),
 				InstanceId:     types.StringValue("iid"),
 				Name:           types.StringValue("name"),
@@ -0,0 +1,642 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	sdkWait "github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	internalUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &scrapeConfigsResource{}
+	_ resource.ResourceWithConfigure   = &scrapeConfigsResource{}
+	_ resource.ResourceWithImportState = &scrapeConfigsResource{}
+	_ resource.ResourceWithModifyPlan  = &scrapeConfigsResource{}
+)
+
+// scrapeConfigsModel maps the bulk resource schema data.
+type scrapeConfigsModel struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ImportId   types.String `tfsdk:"import_id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	Jobs       types.Map    `tfsdk:"jobs"`
+}
+
+// Struct corresponding to scrapeConfigsModel.Jobs[name]
+type jobModel struct {
+	MetricsPath    types.String `tfsdk:"metrics_path"`
+	Scheme         types.String `tfsdk:"scheme"`
+	ScrapeInterval types.String `tfsdk:"scrape_interval"`
+	ScrapeTimeout  types.String `tfsdk:"scrape_timeout"`
+	SampleLimit    types.Int64  `tfsdk:"sample_limit"`
+	SAML2          types.Object `tfsdk:"saml2"`
+	BasicAuth      types.Object `tfsdk:"basic_auth"`
+	Targets        types.List   `tfsdk:"targets"`
+}
+
+// Types corresponding to jobModel
+var jobTypes = map[string]attr.Type{
+	"metrics_path":    types.StringType,
+	"scheme":          types.StringType,
+	"scrape_interval": types.StringType,
+	"scrape_timeout":  types.StringType,
+	"sample_limit":    types.Int64Type,
+	"saml2":           types.ObjectType{AttrTypes: saml2Types},
+	"basic_auth":      types.ObjectType{AttrTypes: basicAuthTypes},
+	"targets":         types.ListType{ElemType: types.ObjectType{AttrTypes: targetTypes}},
+}
+
+// NewScrapeConfigsResource is a helper function to simplify the provider implementation.
+func NewScrapeConfigsResource() resource.Resource {
+	return &scrapeConfigsResource{}
+}
+
+// scrapeConfigsResource is the resource implementation.
+type scrapeConfigsResource struct {
+	client       *observability.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *scrapeConfigsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_scrapeconfigs"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *scrapeConfigsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *observability.APIClient
+	var err error
+	if r.providerData.ObservabilityCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, observability.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.ObservabilityCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&r.providerData, observability.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the resource configuration", err))
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "Observability scrape configs client configured")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to list the sensitive attributes persisted to the state, if the audit is enabled.
+func (r *scrapeConfigsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	if r.providerData.EnableSensitiveAttributesAudit {
+		internalUtils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *scrapeConfigsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Observability scrape configs resource schema. Manages many scraping jobs of an instance at once, which is much faster than one `stackit_observability_scrapeconfig` resource per job. Only the jobs declared in `jobs` are managed, other jobs of the instance are not changed. Don't manage the same job with both resources. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block. Importing adds all scraping jobs of the instance.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the scraping jobs are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "Observability instance ID to which the scraping jobs are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"jobs": schema.MapNestedAttribute{
+				Description: "The scraping jobs, keyed by the name of the job. The map can be read from a YAML or JSON document with `yamldecode` or `jsondecode`.",
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						validate.NoSeparator(),
+						stringvalidator.LengthBetween(1, 200),
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: jobsAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// jobsAttributes returns the attributes of the elements of jobs, which are the ones of the scrape config resource,
+// except that basic_auth is not computed: unset computed attributes of map elements are planned as unknown
+// whenever the map changes, which would mark every job without basic_auth as changed.
+func jobsAttributes() map[string]schema.Attribute {
+	attributes := jobAttributes()
+	basicAuth := attributes["basic_auth"].(schema.SingleNestedAttribute)
+	basicAuth.Computed = false
+	attributes["basic_auth"] = basicAuth
+	return attributes
+}
+
+// Create creates the scraping jobs and sets the initial Terraform state.
+func (r *scrapeConfigsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model scrapeConfigsModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	planJobs, err := jobsFromModel(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape configs", fmt.Sprintf("Processing plan: %v", err))
+		return
+	}
+
+	// If some of the jobs couldn't be created, the ones which exist are still saved in the state
+	err = r.applyJobs(ctx, &model, planJobs, map[string]jobModel{})
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape configs", err.Error())
+	}
+
+	scResp, err := r.client.ListScrapeConfigs(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape configs", fmt.Sprintf("Calling API for updated data: %v", err))
+		return
+	}
+	err = mapScrapeConfigsFields(ctx, scResp, &model, planJobs)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape configs", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability scrape configs created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *scrapeConfigsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model scrapeConfigsModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	// After an import no jobs are set, in that case all jobs of the instance are read
+	var stateJobs map[string]jobModel
+	if !model.Jobs.IsNull() {
+		var err error
+		stateJobs, err = jobsFromModel(ctx, &model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading scrape configs", fmt.Sprintf("Processing state: %v", err))
+			return
+		}
+	}
+
+	scResp, err := r.client.ListScrapeConfigs(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading scrape configs", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapScrapeConfigsFields(ctx, scResp, &model, stateJobs)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading scrape configs", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set refreshed model
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability scrape configs read")
+}
+
+// Update creates, updates and deletes the scraping jobs which changed and sets the updated Terraform state on success.
+func (r *scrapeConfigsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model scrapeConfigsModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel scrapeConfigsModel
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	planJobs, err := jobsFromModel(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape configs", fmt.Sprintf("Processing plan: %v", err))
+		return
+	}
+	stateJobs, err := jobsFromModel(ctx, &stateModel)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape configs", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+
+	// If some of the changes failed, the jobs are still saved in the state as they are now
+	err = r.applyJobs(ctx, &model, planJobs, stateJobs)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape configs", err.Error())
+	}
+
+	// Jobs which should have been deleted are kept in the state if they still exist
+	managedJobs := map[string]jobModel{}
+	for name, job := range stateJobs {
+		managedJobs[name] = job
+	}
+	for name, job := range planJobs {
+		managedJobs[name] = job
+	}
+
+	scResp, err := r.client.ListScrapeConfigs(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape configs", fmt.Sprintf("Calling API for updated data: %v", err))
+		return
+	}
+	err = mapScrapeConfigsFields(ctx, scResp, &model, managedJobs)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating scrape configs", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability scrape configs updated")
+}
+
+// Delete deletes the scraping jobs and removes the Terraform state on success.
+func (r *scrapeConfigsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model scrapeConfigsModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "instance_id", model.InstanceId.ValueString())
+
+	stateJobs, err := jobsFromModel(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape configs", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+
+	err = r.applyJobs(ctx, &model, map[string]jobModel{}, stateJobs)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape configs", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Observability scrape configs deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,instance_id
+func (r *scrapeConfigsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing scrape configs",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+	tflog.Info(ctx, "Observability scrape configs state imported")
+}
+
+// applyJobs brings the scraping jobs of the instance from the prior to the planned set.
+// The API calls are made one after another, but the waiting is done once for all of them.
+func (r *scrapeConfigsResource) applyJobs(ctx context.Context, model *scrapeConfigsModel, planJobs, priorJobs map[string]jobModel) error {
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+
+	toCreate, toUpdate, toDelete := computeJobsDiff(planJobs, priorJobs)
+	var errs []error
+
+	deleted := []string{}
+	for _, name := range toDelete {
+		_, err := r.client.DeleteScrapeConfig(ctx, instanceId, name, projectId).Execute()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("deleting job %q: calling API: %w", name, err))
+			continue
+		}
+		deleted = append(deleted, name)
+	}
+
+	created := []string{}
+	for _, name := range toCreate {
+		jobScModel := planJobs[name].toModel(model, name)
+		saml2Model, basicAuthModel, targetsModel, err := jobPayloadInputs(ctx, &jobScModel)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("creating job %q: creating API payload: %w", name, err))
+			continue
+		}
+		payload, err := toCreatePayload(ctx, &jobScModel, saml2Model, basicAuthModel, targetsModel)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("creating job %q: creating API payload: %w", name, err))
+			continue
+		}
+		_, err = r.client.CreateScrapeConfig(ctx, instanceId, projectId).CreateScrapeConfigPayload(*payload).Execute()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("creating job %q: calling API: %w", name, err))
+			continue
+		}
+		created = append(created, name)
+	}
+
+	updated := 0
+	for _, name := range toUpdate {
+		jobScModel := planJobs[name].toModel(model, name)
+		saml2Model, basicAuthModel, targetsModel, err := jobPayloadInputs(ctx, &jobScModel)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("updating job %q: creating API payload: %w", name, err))
+			continue
+		}
+		payload, err := toUpdatePayload(ctx, &jobScModel, saml2Model, basicAuthModel, targetsModel)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("updating job %q: creating API payload: %w", name, err))
+			continue
+		}
+		_, err = r.client.UpdateScrapeConfig(ctx, instanceId, name, projectId).UpdateScrapeConfigPayload(*payload).Execute()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("updating job %q: calling API: %w", name, err))
+			continue
+		}
+		updated++
+	}
+	tflog.Info(ctx, fmt.Sprintf("Requested creation of %d, update of %d and deletion of %d scrape configs", len(created), updated, len(deleted)))
+
+	if len(deleted) > 0 {
+		_, err := internalUtils.Wait(ctx, scrapeConfigsWaitHandler(ctx, r.client, instanceId, projectId, deleted, false), "Observability scrape configs deletion")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("scrape configs deletion waiting: %w", err))
+		}
+	}
+	if len(created) > 0 {
+		_, err := internalUtils.Wait(ctx, scrapeConfigsWaitHandler(ctx, r.client, instanceId, projectId, created, true), "Observability scrape configs creation")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("scrape configs creation waiting: %w", err))
+		}
+	}
+	// The delay is needed once for all updated jobs
+	if updated > 0 {
+		waitForScrapeConfigUpdate(ctx)
+	}
+	return errors.Join(errs...)
+}
+
+// scrapeConfigsWaitHandler waits until all the given jobs exist, or until none of them exists anymore
+func scrapeConfigsWaitHandler(ctx context.Context, a wait.APIClientInterface, instanceId, projectId string, jobNames []string, exist bool) *sdkWait.AsyncActionHandler[observability.ListScrapeConfigsResponse] {
+	handler := sdkWait.New(func() (waitFinished bool, response *observability.ListScrapeConfigsResponse, err error) {
+		s, err := a.ListScrapeConfigsExecute(ctx, instanceId, projectId)
+		if err != nil {
+			return false, nil, err
+		}
+		existing := map[string]bool{}
+		if s.Data != nil {
+			for _, job := range *s.Data {
+				if job.JobName != nil {
+					existing[*job.JobName] = true
+				}
+			}
+		}
+		for _, name := range jobNames {
+			if existing[name] != exist {
+				return false, nil, nil
+			}
+		}
+		return true, s, nil
+	})
+	handler.SetTimeout(10 * time.Minute)
+	return handler
+}
+
+// computeJobsDiff returns the sorted names of the jobs which have to be created, updated and deleted
+func computeJobsDiff(planJobs, priorJobs map[string]jobModel) (toCreate, toUpdate, toDelete []string) {
+	toCreate = []string{}
+	toUpdate = []string{}
+	toDelete = []string{}
+	for name, planJob := range planJobs {
+		priorJob, ok := priorJobs[name]
+		if !ok {
+			toCreate = append(toCreate, name)
+			continue
+		}
+		if !planJob.equal(priorJob) {
+			toUpdate = append(toUpdate, name)
+		}
+	}
+	for name := range priorJobs {
+		if _, ok := planJobs[name]; !ok {
+			toDelete = append(toDelete, name)
+		}
+	}
+	sort.Strings(toCreate)
+	sort.Strings(toUpdate)
+	sort.Strings(toDelete)
+	return toCreate, toUpdate, toDelete
+}
+
+func (j jobModel) equal(other jobModel) bool {
+	return j.MetricsPath.Equal(other.MetricsPath) &&
+		j.Scheme.Equal(other.Scheme) &&
+		j.ScrapeInterval.Equal(other.ScrapeInterval) &&
+		j.ScrapeTimeout.Equal(other.ScrapeTimeout) &&
+		j.SampleLimit.Equal(other.SampleLimit) &&
+		j.SAML2.Equal(other.SAML2) &&
+		j.BasicAuth.Equal(other.BasicAuth) &&
+		j.Targets.Equal(other.Targets)
+}
+
+// toModel converts the job to the model of a single scrape config, so that its payload and mapping functions can be reused
+func (j jobModel) toModel(model *scrapeConfigsModel, name string) Model {
+	return Model{
+		ProjectId:      model.ProjectId,
+		InstanceId:     model.InstanceId,
+		Name:           types.StringValue(name),
+		MetricsPath:    j.MetricsPath,
+		Scheme:         j.Scheme,
+		ScrapeInterval: j.ScrapeInterval,
+		ScrapeTimeout:  j.ScrapeTimeout,
+		SampleLimit:    j.SampleLimit,
+		SAML2:          j.SAML2,
+		BasicAuth:      j.BasicAuth,
+		Targets:        j.Targets,
+	}
+}
+
+func jobsFromModel(ctx context.Context, model *scrapeConfigsModel) (map[string]jobModel, error) {
+	jobs := map[string]jobModel{}
+	if model.Jobs.IsNull() || model.Jobs.IsUnknown() {
+		return jobs, nil
+	}
+	diags := model.Jobs.ElementsAs(ctx, &jobs, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("mapping jobs: %w", core.DiagsToError(diags))
+	}
+	return jobs, nil
+}
+
+// mapScrapeConfigsFields maps the managed jobs of the API response to the model.
+// Managed jobs are given with their prior values, which are needed to map the fields the API doesn't return as they were set.
+// If managedJobs is nil, all jobs of the instance are mapped.
+func mapScrapeConfigsFields(ctx context.Context, scResp *observability.ListScrapeConfigsResponse, model *scrapeConfigsModel, managedJobs map[string]jobModel) error {
+	if scResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	idParts := []string{
+		model.ProjectId.ValueString(),
+		model.InstanceId.ValueString(),
+	}
+	model.Id = types.StringValue(
+		strings.Join(idParts, core.Separator),
+	)
+	model.ImportId = model.Id
+
+	jobs := map[string]attr.Value{}
+	if scResp.Data != nil {
+		for i := range *scResp.Data {
+			sc := (*scResp.Data)[i]
+			if sc.JobName == nil {
+				return fmt.Errorf("scrape config name not present")
+			}
+			name := *sc.JobName
+
+			priorJob, ok := managedJobs[name]
+			if managedJobs != nil && !ok {
+				continue
+			}
+			if !ok {
+				priorJob = jobModel{
+					SAML2:     types.ObjectNull(saml2Types),
+					BasicAuth: types.ObjectNull(basicAuthTypes),
+					Targets:   types.ListNull(types.ObjectType{AttrTypes: targetTypes}),
+				}
+			}
+
+			jobScModel := priorJob.toModel(model, name)
+			err := mapFields(ctx, &sc, &jobScModel)
+			if err != nil {
+				return fmt.Errorf("mapping job %q: %w", name, err)
+			}
+			jobTF, diags := types.ObjectValue(jobTypes, map[string]attr.Value{
+				"metrics_path":    jobScModel.MetricsPath,
+				"scheme":          jobScModel.Scheme,
+				"scrape_interval": jobScModel.ScrapeInterval,
+				"scrape_timeout":  jobScModel.ScrapeTimeout,
+				"sample_limit":    jobScModel.SampleLimit,
+				"saml2":           jobScModel.SAML2,
+				"basic_auth":      jobScModel.BasicAuth,
+				"targets":         jobScModel.Targets,
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping job %q: %w", name, core.DiagsToError(diags))
+			}
+			jobs[name] = jobTF
+		}
+	}
+
+	jobsTF, diags := types.MapValue(types.ObjectType{AttrTypes: jobTypes}, jobs)
+	if diags.HasError() {
+		return fmt.Errorf("mapping jobs: %w", core.DiagsToError(diags))
+	}
+	model.Jobs = jobsTF
+	return nil
+}
@@ -0,0 +1,229 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+func fixtureJobModel(metricsPath string) jobModel {
+	return jobModel{
+		MetricsPath:    types.StringValue(metricsPath),
+		Scheme:         types.StringValue(DefaultScheme),
+		ScrapeInterval: types.StringValue(DefaultScrapeInterval),
+		ScrapeTimeout:  types.StringValue(DefaultScrapeTimeout),
+		SampleLimit:    types.Int64Value(DefaultSampleLimit),
+		SAML2: types.ObjectValueMust(saml2Types, map[string]attr.Value{
+			"enable_url_parameters": types.BoolValue(true),
+		}),
+		BasicAuth: types.ObjectNull(basicAuthTypes),
+		Targets: types.ListValueMust(types.ObjectType{AttrTypes: targetTypes}, []attr.Value{
+			types.ObjectValueMust(targetTypes, map[string]attr.Value{
+				"urls":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("url1")}),
+				"labels": types.MapNull(types.StringType),
+			}),
+		}),
+	}
+}
+
+func fixtureJob(name, metricsPath string) observability.Job {
+	return observability.Job{
+		JobName:        utils.Ptr(name),
+		MetricsPath:    utils.Ptr(metricsPath),
+		Scheme:         utils.Ptr(DefaultScheme),
+		ScrapeInterval: utils.Ptr(DefaultScrapeInterval),
+		ScrapeTimeout:  utils.Ptr(DefaultScrapeTimeout),
+		SampleLimit:    utils.Ptr(DefaultSampleLimit),
+		Params:         &map[string][]string{"saml2": {"enabled"}},
+		StaticConfigs: &[]observability.StaticConfigs{
+			{
+				Targets: &[]string{"url1"},
+			},
+		},
+	}
+}
+
+func jobValue(t *testing.T, job jobModel) attr.Value {
+	value, diags := types.ObjectValueFrom(context.Background(), jobTypes, job)
+	if diags.HasError() {
+		t.Fatalf("Converting job: %v", diags.Errors())
+	}
+	return value
+}
+
+func TestMapScrapeConfigsFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *observability.ListScrapeConfigsResponse
+		managedJobs map[string]jobModel
+		expected    map[string]jobModel
+		isValid     bool
+	}{
+		{
+			"managed_jobs",
+			&observability.ListScrapeConfigsResponse{
+				Data: &[]observability.Job{
+					fixtureJob("job-1", "/metrics"),
+					fixtureJob("job-2", "/other"),
+					fixtureJob("unmanaged", "/metrics"),
+				},
+			},
+			map[string]jobModel{
+				"job-1":   fixtureJobModel("/metrics"),
+				"job-2":   fixtureJobModel("/metrics"),
+				"deleted": fixtureJobModel("/metrics"),
+			},
+			map[string]jobModel{
+				"job-1": fixtureJobModel("/metrics"),
+				"job-2": fixtureJobModel("/other"),
+			},
+			true,
+		},
+		{
+			"all_jobs_after_import",
+			&observability.ListScrapeConfigsResponse{
+				Data: &[]observability.Job{
+					fixtureJob("job-1", "/metrics"),
+				},
+			},
+			nil,
+			map[string]jobModel{
+				"job-1": fixtureJobModel("/metrics"),
+			},
+			true,
+		},
+		{
+			"no_jobs",
+			&observability.ListScrapeConfigsResponse{},
+			map[string]jobModel{
+				"job-1": fixtureJobModel("/metrics"),
+			},
+			map[string]jobModel{},
+			true,
+		},
+		{
+			"no_job_name",
+			&observability.ListScrapeConfigsResponse{
+				Data: &[]observability.Job{
+					{},
+				},
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &scrapeConfigsModel{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+			}
+			err := mapScrapeConfigsFields(context.Background(), tt.input, model, tt.managedJobs)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				expectedJobs := map[string]attr.Value{}
+				for name, job := range tt.expected {
+					expectedJobs[name] = jobValue(t, job)
+				}
+				expected := &scrapeConfigsModel{
+					Id:         types.StringValue("pid,iid"),
+					ImportId:   types.StringValue("pid,iid"),
+					ProjectId:  types.StringValue("pid"),
+					InstanceId: types.StringValue("iid"),
+					Jobs:       types.MapValueMust(types.ObjectType{AttrTypes: jobTypes}, expectedJobs),
+				}
+				diff := cmp.Diff(model, expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeJobsDiff(t *testing.T) {
+	tests := []struct {
+		description      string
+		planJobs         map[string]jobModel
+		priorJobs        map[string]jobModel
+		expectedToCreate []string
+		expectedToUpdate []string
+		expectedToDelete []string
+	}{
+		{
+			"no_changes",
+			map[string]jobModel{"a": fixtureJobModel("/metrics")},
+			map[string]jobModel{"a": fixtureJobModel("/metrics")},
+			[]string{},
+			[]string{},
+			[]string{},
+		},
+		{
+			"create_all",
+			map[string]jobModel{"b": fixtureJobModel("/metrics"), "a": fixtureJobModel("/metrics")},
+			map[string]jobModel{},
+			[]string{"a", "b"},
+			[]string{},
+			[]string{},
+		},
+		{
+			"delete_all",
+			map[string]jobModel{},
+			map[string]jobModel{"a": fixtureJobModel("/metrics"), "b": fixtureJobModel("/metrics")},
+			[]string{},
+			[]string{},
+			[]string{"a", "b"},
+		},
+		{
+			"mixed_changes",
+			map[string]jobModel{
+				"unchanged": fixtureJobModel("/metrics"),
+				"changed":   fixtureJobModel("/other"),
+				"new":       fixtureJobModel("/metrics"),
+			},
+			map[string]jobModel{
+				"unchanged": fixtureJobModel("/metrics"),
+				"changed":   fixtureJobModel("/metrics"),
+				"removed":   fixtureJobModel("/metrics"),
+			},
+			[]string{"new"},
+			[]string{"changed"},
+			[]string{"removed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			toCreate, toUpdate, toDelete := computeJobsDiff(tt.planJobs, tt.priorJobs)
+			diff := cmp.Diff(toCreate, tt.expectedToCreate)
+			if diff != "" {
+				t.Fatalf("Jobs to create do not match: %s", diff)
+			}
+			diff = cmp.Diff(toUpdate, tt.expectedToUpdate)
+			if diff != "" {
+				t.Fatalf("Jobs to update do not match: %s", diff)
+			}
+			diff = cmp.Diff(toDelete, tt.expectedToDelete)
+			if diff != "" {
+				t.Fatalf("Jobs to delete do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -54,12 +54,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var apiClient *opensearch.APIClient
 	var err error
 	if providerData.OpenSearchCustomEndpoint != "" {
-		apiClient, err = opensearch.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, opensearch.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.OpenSearchCustomEndpoint),
 		)
 	} else {
-		apiClient, err = opensearch.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, opensearch.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -79,12 +79,14 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	descriptions := map[string]string{
 		"main":        "OpenSearch instance data source schema. Must have a `region` specified in the provider configuration.",
 		"id":          "Terraform's internal data source. identifier. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":   "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id": "ID of the OpenSearch instance.",
 		"project_id":  "STACKIT Project ID to which the instance is associated.",
 		"name":        "Instance name.",
 		"version":     "The service version.",
 		"plan_name":   "The selected plan name.",
 		"plan_id":     "The selected plan ID.",
+		"status":      "The status of the instance, e.g. `active` or `failed`.",
 	}
 
 	parametersDescriptions := map[string]string{
@@ -111,6 +113,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Required:    true,
@@ -143,6 +149,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["plan_id"],
 				Computed:    true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"parameters": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"sgw_acl": schema.StringAttribute{
@@ -84,6 +84,7 @@ func TestMapFields(t *testing.T) {
 			&opensearch.Instance{},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringNull(),
@@ -93,6 +94,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringNull(),
 				ImageUrl:           types.StringNull(),
 				CfOrganizationGuid: types.StringNull(),
+				Status:             types.StringNull(),
 				Parameters:         types.ObjectNull(parametersTypes),
 			},
 			true,
@@ -108,6 +110,7 @@ func TestMapFields(t *testing.T) {
 				InstanceId:         utils.Ptr("iid"),
 				Name:               utils.Ptr("name"),
 				CfOrganizationGuid: utils.Ptr("org"),
+				Status:             utils.Ptr("active"),
 				Parameters: &map[string]interface{}{
 					// Using "-" on purpose on some fields because that is the API response
 					"sgw_acl":                "acl",
@@ -128,6 +131,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringValue("plan"),
@@ -137,6 +141,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringValue("dashboard"),
 				ImageUrl:           types.StringValue("image"),
 				CfOrganizationGuid: types.StringValue("org"),
+				Status:             types.StringValue("active"),
 				Parameters:         fixtureModelParameters,
 			},
 			true,
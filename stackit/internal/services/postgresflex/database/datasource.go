@@ -29,7 +29,8 @@ func NewDatabaseDataSource() datasource.DataSource {
 
 // databaseDataSource is the data source implementation.
 type databaseDataSource struct {
-	client *postgresflex.APIClient
+	client        *postgresflex.APIClient
+	responseCache *core.ResponseCache
 }
 
 // Metadata returns the data source type name.
@@ -53,12 +54,12 @@ func (r *databaseDataSource) Configure(ctx context.Context, req datasource.Confi
 	var apiClient *postgresflex.APIClient
 	var err error
 	if providerData.PostgresFlexCustomEndpoint != "" {
-		apiClient, err = postgresflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = postgresflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -70,6 +71,7 @@ func (r *databaseDataSource) Configure(ctx context.Context, req datasource.Confi
 	}
 
 	r.client = apiClient
+	r.responseCache = providerData.ResponseCache
 	tflog.Info(ctx, "Postgres Flex database client configured")
 }
 
@@ -78,6 +80,7 @@ func (r *databaseDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	descriptions := map[string]string{
 		"main":        "Postgres Flex database resource schema. Must have a `region` specified in the provider configuration.",
 		"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`database_id`\".",
+		"import_id":   "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"database_id": "Database ID.",
 		"instance_id": "ID of the Postgres Flex instance.",
 		"project_id":  "STACKIT project ID to which the instance is associated.",
@@ -92,6 +95,10 @@ func (r *databaseDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"database_id": schema.StringAttribute{
 				Description: descriptions["database_id"],
 				Required:    true,
@@ -142,7 +149,7 @@ func (r *databaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 	ctx = tflog.SetField(ctx, "database_id", databaseId)
 
-	databaseResp, err := getDatabase(ctx, r.client, projectId, instanceId, databaseId)
+	databaseResp, err := getDatabase(ctx, r.client, r.responseCache, projectId, instanceId, databaseId)
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if ok && oapiErr.StatusCode == http.StatusNotFound {
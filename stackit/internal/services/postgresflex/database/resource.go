@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,10 +29,12 @@ var (
 	_ resource.Resource                = &databaseResource{}
 	_ resource.ResourceWithConfigure   = &databaseResource{}
 	_ resource.ResourceWithImportState = &databaseResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseResource{}
 )
 
 type Model struct {
 	Id         types.String `tfsdk:"id"` // needed by TF
+	ImportId   types.String `tfsdk:"import_id"`
 	DatabaseId types.String `tfsdk:"database_id"`
 	InstanceId types.String `tfsdk:"instance_id"`
 	ProjectId  types.String `tfsdk:"project_id"`
@@ -46,7 +49,8 @@ func NewDatabaseResource() resource.Resource {
 
 // databaseResource is the resource implementation.
 type databaseResource struct {
-	client *postgresflex.APIClient
+	client       *postgresflex.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -61,7 +65,8 @@ func (r *databaseResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -69,15 +74,15 @@ func (r *databaseResource) Configure(ctx context.Context, req resource.Configure
 
 	var apiClient *postgresflex.APIClient
 	var err error
-	if providerData.PostgresFlexCustomEndpoint != "" {
-		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
+	if r.providerData.PostgresFlexCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -90,16 +95,84 @@ func (r *databaseResource) Configure(ctx context.Context, req resource.Configure
 	tflog.Info(ctx, "Postgres Flex database client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective project ID in the current plan, to warn about the replacement
+// of the database when its owner changes and to warn about an already existing database with the same
+// name when planning its creation.
+func (r *databaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	var configModel Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptProjectId(ctx, configModel.ProjectId, &planModel.ProjectId, r.providerData.DefaultProjectId, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API doesn't support changing the owner of a database, so it is replaced
+	if !req.State.Raw.IsNull() {
+		var stateModel Model
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planModel.Owner.IsUnknown() && !planModel.Owner.Equal(stateModel.Owner) {
+			core.LogAndAddWarning(ctx, &resp.Diagnostics, "Changing the owner replaces the database",
+				fmt.Sprintf("The Postgres Flex API doesn't support changing the owner of a database, so database %q is deleted and created again with owner %q. All data stored in the database is lost. Back up the data first, or keep the current owner %q.", stateModel.Name.ValueString(), planModel.Owner.ValueString(), stateModel.Owner.ValueString()))
+		}
+	}
+
+	if !r.providerData.EnablePlanTimeChecks || !req.State.Raw.IsNull() {
+		return
+	}
+	if planModel.ProjectId.IsUnknown() || planModel.InstanceId.IsUnknown() || planModel.Name.IsUnknown() {
+		return
+	}
+	projectId := planModel.ProjectId.ValueString()
+	instanceId := planModel.InstanceId.ValueString()
+	databaseName := planModel.Name.ValueString()
+
+	databasesResp, err := listDatabases(ctx, r.client, r.providerData.ResponseCache, projectId, instanceId)
+	if err != nil {
+		// The instance may not exist yet, any other error will surface during apply
+		tflog.Debug(ctx, fmt.Sprintf("Plan-time check for database %q: %v", databaseName, err))
+		return
+	}
+	if databasesResp == nil || databasesResp.Databases == nil {
+		return
+	}
+	for _, database := range *databasesResp.Databases {
+		if database.Name != nil && *database.Name == databaseName {
+			core.LogAndAddWarning(ctx, &resp.Diagnostics, "Database already exists",
+				fmt.Sprintf("A database with name %q already exists on instance %q, creating it will fail. Import the existing database or choose a different name.", databaseName, instanceId))
+			return
+		}
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":        "Postgres Flex database resource schema. Must have a `region` specified in the provider configuration.",
 		"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`database_id`\".",
+		"import_id":   "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"database_id": "Database ID.",
 		"instance_id": "ID of the Postgres Flex instance.",
-		"project_id":  "STACKIT project ID to which the instance is associated.",
+		"project_id":  "STACKIT project ID to which the instance is associated. If not defined, the provider `default_project_id` is used.",
 		"name":        "Database name.",
-		"owner":       "Username of the database owner.",
+		"owner":       "Username of the database owner. Changing it replaces the database and all its data is lost, since the API doesn't support changing the owner of a database.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -112,6 +185,13 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"database_id": schema.StringAttribute{
 				Description: descriptions["database_id"],
 				Computed:    true,
@@ -136,7 +216,9 @@ func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"project_id": schema.StringAttribute{
 				Description: descriptions["project_id"],
-				Required:    true,
+				Optional:    true,
+				// must be computed to allow for storing the default project ID from the provider
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
@@ -186,7 +268,7 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new database
 	databaseResp, err := r.client.CreateDatabase(ctx, projectId, instanceId).CreateDatabasePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating database", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating database", err, req.Plan)
 		return
 	}
 	if databaseResp == nil || databaseResp.Id == nil || *databaseResp.Id == "" {
@@ -195,8 +277,9 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	databaseId := *databaseResp.Id
 	ctx = tflog.SetField(ctx, "database_id", databaseId)
+	r.providerData.ResponseCache.Invalidate(databasesCacheKey(projectId, instanceId))
 
-	database, err := getDatabase(ctx, r.client, projectId, instanceId, databaseId)
+	database, err := getDatabase(ctx, r.client, r.providerData.ResponseCache, projectId, instanceId, databaseId)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating database", fmt.Sprintf("Getting database details after creation: %v", err))
 		return
@@ -232,7 +315,7 @@ func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 	ctx = tflog.SetField(ctx, "database_id", databaseId)
 
-	databaseResp, err := getDatabase(ctx, r.client, projectId, instanceId, databaseId)
+	databaseResp, err := getDatabase(ctx, r.client, r.providerData.ResponseCache, projectId, instanceId, databaseId)
 	if err != nil {
 		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
 		if (ok && oapiErr.StatusCode == http.StatusNotFound) || errors.Is(err, databaseNotFoundErr) {
@@ -284,6 +367,7 @@ func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	// Delete existing record set
 	err := r.client.DeleteDatabase(ctx, projectId, instanceId, databaseId).Execute()
+	r.providerData.ResponseCache.Invalidate(databasesCacheKey(projectId, instanceId))
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting database", fmt.Sprintf("Calling API: %v", err))
 	}
@@ -339,6 +423,7 @@ func mapFields(databaseResp *postgresflex.InstanceDatabase, model *Model) error
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.DatabaseId = types.StringValue(databaseId)
 	model.Name = types.StringPointerValue(databaseResp.Name)
 
@@ -374,9 +459,22 @@ func toCreatePayload(model *Model) (*postgresflex.CreateDatabasePayload, error)
 
 var databaseNotFoundErr = errors.New("database not found")
 
+// databasesCacheKey returns the key of the databases of an instance in the response cache of the provider.
+func databasesCacheKey(projectId, instanceId string) string {
+	return strings.Join([]string{"postgresflex_databases", projectId, instanceId}, core.Separator)
+}
+
+// listDatabases lists the databases of an instance. The response is shared by all databases of the instance,
+// so that refreshing many databases doesn't request the same list for each of them.
+func listDatabases(ctx context.Context, client *postgresflex.APIClient, cache *core.ResponseCache, projectId, instanceId string) (*postgresflex.InstanceListDatabasesResponse, error) {
+	return core.CachedResponse(cache, databasesCacheKey(projectId, instanceId), func() (*postgresflex.InstanceListDatabasesResponse, error) {
+		return client.ListDatabases(ctx, projectId, instanceId).Execute()
+	})
+}
+
 // The API does not have a GetDatabase endpoint, only ListDatabases
-func getDatabase(ctx context.Context, client *postgresflex.APIClient, projectId, instanceId, databaseId string) (*postgresflex.InstanceDatabase, error) {
-	resp, err := client.ListDatabases(ctx, projectId, instanceId).Execute()
+func getDatabase(ctx context.Context, client *postgresflex.APIClient, cache *core.ResponseCache, projectId, instanceId, databaseId string) (*postgresflex.InstanceDatabase, error) {
+	resp, err := listDatabases(ctx, client, cache, projectId, instanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -23,6 +23,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				DatabaseId: types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -42,6 +43,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				DatabaseId: types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -61,6 +63,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid,uid"),
+				ImportId:   types.StringValue("pid,iid,uid"),
 				DatabaseId: types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -56,12 +56,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var apiClient *postgresflex.APIClient
 	var err error
 	if providerData.PostgresFlexCustomEndpoint != "" {
-		apiClient, err = postgresflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = postgresflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -81,10 +81,13 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	descriptions := map[string]string{
 		"main":        "Postgres Flex instance data source schema. Must have a `region` specified in the provider configuration.",
 		"id":          "Terraform's internal data source. ID. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":   "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id": "ID of the PostgresFlex instance.",
 		"project_id":  "STACKIT project ID to which the instance is associated.",
 		"name":        "Instance name.",
 		"acl":         "The Access Control List (ACL) for the PostgresFlex instance.",
+		"status":      "Status of the PostgresFlex instance, e.g. `Ready`.",
+		"options":     "Custom options of the PostgresFlex instance, as returned by the API.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -94,6 +97,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Required:    true,
@@ -156,6 +163,15 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"version": schema.StringAttribute{
 				Computed: true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+			"options": schema.MapAttribute{
+				Description: descriptions["options"],
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 		},
 	}
 }
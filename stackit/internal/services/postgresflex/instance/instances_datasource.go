@@ -0,0 +1,184 @@
+package postgresflex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &instancesDataSource{}
+)
+
+// NewInstancesDataSource is a helper function to simplify the provider implementation.
+func NewInstancesDataSource() datasource.DataSource {
+	return &instancesDataSource{}
+}
+
+// instancesDataSource is the data source implementation.
+type instancesDataSource struct {
+	client *postgresflex.APIClient
+}
+
+// instancesDataSourceModel maps the data source schema data.
+type instancesDataSourceModel struct {
+	Id        types.String                   `tfsdk:"id"` // needed by TF
+	ProjectId types.String                   `tfsdk:"project_id"`
+	Items     []instancesDataSourceItemModel `tfsdk:"items"`
+}
+
+// instancesDataSourceItemModel maps instance schema data.
+type instancesDataSourceItemModel struct {
+	InstanceId types.String `tfsdk:"instance_id"`
+	Name       types.String `tfsdk:"name"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// Metadata returns the data source type name.
+func (r *instancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_instances"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *instancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *postgresflex.APIClient
+	var err error
+	if providerData.PostgresFlexCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "Postgres Flex instances client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *instancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Postgres Flex instances data source schema. Lists all PostgresFlex instances of a project. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the instances are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The PostgresFlex instances of the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Description: "ID of the PostgresFlex instance.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Instance name.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the PostgresFlex instance, e.g. `Ready`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *instancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model instancesDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	instancesResp, err := r.client.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instances", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapInstancesDataSourceFields(instancesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instances", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex instances read")
+}
+
+func mapInstancesDataSourceFields(instancesResp *postgresflex.ListInstancesResponse, model *instancesDataSourceModel) error {
+	if instancesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	model.Items = []instancesDataSourceItemModel{}
+	if instancesResp.Items == nil {
+		return nil
+	}
+	for i := range *instancesResp.Items {
+		instance := &(*instancesResp.Items)[i]
+		if instance.Id == nil {
+			return fmt.Errorf("instance id not present")
+		}
+		model.Items = append(model.Items, instancesDataSourceItemModel{
+			InstanceId: types.StringPointerValue(instance.Id),
+			Name:       types.StringPointerValue(instance.Name),
+			Status:     types.StringPointerValue(instance.Status),
+		})
+	}
+	return nil
+}
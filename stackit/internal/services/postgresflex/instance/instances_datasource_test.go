@@ -0,0 +1,98 @@
+package postgresflex
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+func TestMapInstancesDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *postgresflex.ListInstancesResponse
+		expected    instancesDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_instances",
+			&postgresflex.ListInstancesResponse{},
+			instancesDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items:     []instancesDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&postgresflex.ListInstancesResponse{
+				Items: &[]postgresflex.InstanceListInstance{
+					{
+						Id:     utils.Ptr("iid"),
+						Name:   utils.Ptr("name"),
+						Status: utils.Ptr("Ready"),
+					},
+					{
+						Id: utils.Ptr("iid-2"),
+					},
+				},
+			},
+			instancesDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items: []instancesDataSourceItemModel{
+					{
+						InstanceId: types.StringValue("iid"),
+						Name:       types.StringValue("name"),
+						Status:     types.StringValue("Ready"),
+					},
+					{
+						InstanceId: types.StringValue("iid-2"),
+						Name:       types.StringNull(),
+						Status:     types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_instance_id",
+			&postgresflex.ListInstancesResponse{
+				Items: &[]postgresflex.InstanceListInstance{
+					{},
+				},
+			},
+			instancesDataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			instancesDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &instancesDataSourceModel{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapInstancesDataSourceFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -22,11 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkWait "github.com/stackitcloud/stackit-sdk-go/core/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
 	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/wait"
 )
@@ -36,10 +38,12 @@ var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
+	ImportId       types.String `tfsdk:"import_id"`
 	InstanceId     types.String `tfsdk:"instance_id"`
 	ProjectId      types.String `tfsdk:"project_id"`
 	Name           types.String `tfsdk:"name"`
@@ -49,6 +53,14 @@ type Model struct {
 	Replicas       types.Int64  `tfsdk:"replicas"`
 	Storage        types.Object `tfsdk:"storage"`
 	Version        types.String `tfsdk:"version"`
+	Status         types.String `tfsdk:"status"`
+	Options        types.Map    `tfsdk:"options"`
+}
+
+// ResourceModel extends the Model shared with the data source by the resource-only timeouts block
+type ResourceModel struct {
+	Model
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 // Struct corresponding to Model.Flavor
@@ -86,7 +98,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *postgresflex.APIClient
+	client       *postgresflex.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -101,7 +114,8 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -109,15 +123,15 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 
 	var apiClient *postgresflex.APIClient
 	var err error
-	if providerData.PostgresFlexCustomEndpoint != "" {
-		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
+	if r.providerData.PostgresFlexCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = postgresflex.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -130,15 +144,46 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	tflog.Info(ctx, "Postgres Flex instance client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective project ID in the current plan
+// and to validate the project when planning the creation of the instance, if enabled.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	var configModel ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptProjectId(ctx, configModel.ProjectId, &planModel.ProjectId, r.providerData.DefaultProjectId, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *instanceResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":        "Postgres Flex instance resource schema. Must have a `region` specified in the provider configuration.",
 		"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":   "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id": "ID of the PostgresFlex instance.",
-		"project_id":  "STACKIT project ID to which the instance is associated.",
+		"project_id":  "STACKIT project ID to which the instance is associated. If not defined, the provider `default_project_id` is used.",
 		"name":        "Instance name.",
 		"acl":         "The Access Control List (ACL) for the PostgresFlex instance.",
+		"status":      "Status of the PostgresFlex instance, e.g. `Ready`. Updates of the flavor or the storage only complete once the instance is `Ready` again with the new specs.",
+		"options":     "Custom options of the PostgresFlex instance, e.g. to enable features that are not exposed as attributes. The keys and values are passed to the API as is and are not validated by the provider. Only the configured keys are tracked in the state.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -151,6 +196,13 @@ func (r *instanceResource) Schema(_ context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Computed:    true,
@@ -164,7 +216,9 @@ func (r *instanceResource) Schema(_ context.Context, req resource.SchemaRequest,
 			},
 			"project_id": schema.StringAttribute{
 				Description: descriptions["project_id"],
-				Required:    true,
+				Optional:    true,
+				// must be computed to allow for storing the default project ID from the provider
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
@@ -236,6 +290,22 @@ func (r *instanceResource) Schema(_ context.Context, req resource.SchemaRequest,
 			"version": schema.StringAttribute{
 				Required: true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+			"options": schema.MapAttribute{
+				Description: descriptions["options"],
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(),
 		},
 	}
 }
@@ -243,7 +313,7 @@ func (r *instanceResource) Schema(_ context.Context, req resource.SchemaRequest,
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -252,6 +322,12 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
+	createTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutCreate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	var acl []string
 	if !(model.ACL.IsNull() || model.ACL.IsUnknown()) {
 		diags = model.ACL.ElementsAs(ctx, &acl, false)
@@ -267,7 +343,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		err := loadFlavorId(ctx, r.client, &model, flavor)
+		err := loadFlavorId(ctx, r.client, &model.Model, flavor)
 		if err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Loading flavor ID: %v", err))
 			return
@@ -283,7 +359,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(&model, acl, flavor, storage)
+	payload, err := toCreatePayload(&model.Model, acl, flavor, storage)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -291,19 +367,19 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating instance", err, req.Plan)
 		return
 	}
 	instanceId := *createResp.Id
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, utils.WithTimeout(wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId), createTimeout), "PostgreSQL Flex instance creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model, flavor, storage)
+	err = mapFields(ctx, waitResp, &model.Model, flavor, storage)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -319,7 +395,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -363,11 +439,15 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, instanceResp, &model, flavor, storage)
+	err = mapFields(ctx, instanceResp, &model.Model, flavor, storage)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	if model.Status.ValueString() == wait.InstanceStateFailed {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Postgres Flex instance is in a failed state",
+			"The last operation on the instance has failed. Check the instance in the STACKIT portal and, if it does not recover, recreate it with `terraform apply -replace` (or `terraform taint`).")
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -380,7 +460,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *instanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -391,6 +471,12 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	updateTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutUpdate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	var acl []string
 	if !(model.ACL.IsNull() || model.ACL.IsUnknown()) {
 		diags = model.ACL.ElementsAs(ctx, &acl, false)
@@ -406,7 +492,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		err := loadFlavorId(ctx, r.client, &model, flavor)
+		err := loadFlavorId(ctx, r.client, &model.Model, flavor)
 		if err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Loading flavor ID: %v", err))
 			return
@@ -422,7 +508,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(&model, acl, flavor, storage)
+	payload, err := toUpdatePayload(&model.Model, acl, flavor, storage)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -433,14 +519,14 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	waitResp, err := wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, utils.WithTimeout(updateInstanceWaitHandler(ctx, r.client, projectId, instanceId, payload), updateTimeout), "PostgreSQL Flex instance update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, waitResp, &model, flavor, storage)
+	err = mapFields(ctx, waitResp, &model.Model, flavor, storage)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -456,7 +542,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -467,13 +553,19 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 
+	deleteTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutDelete)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	// Delete existing instance
-	err := r.client.DeleteInstance(ctx, projectId, instanceId).Execute()
+	err = r.client.DeleteInstance(ctx, projectId, instanceId).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(45 * time.Minute).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, utils.WithTimeout(wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(45*time.Minute), deleteTimeout), "PostgreSQL Flex instance deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -577,6 +669,11 @@ func mapFields(ctx context.Context, resp *postgresflex.InstanceResponse, model *
 		return fmt.Errorf("creating storage: %w", core.DiagsToError(diags))
 	}
 
+	optionsMap, err := mapOptions(ctx, instance.Options, model.Options)
+	if err != nil {
+		return fmt.Errorf("mapping options: %w", err)
+	}
+
 	idParts := []string{
 		model.ProjectId.ValueString(),
 		instanceId,
@@ -584,6 +681,7 @@ func mapFields(ctx context.Context, resp *postgresflex.InstanceResponse, model *
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.InstanceId = types.StringValue(instanceId)
 	model.Name = types.StringPointerValue(instance.Name)
 	model.ACL = aclList
@@ -592,9 +690,30 @@ func mapFields(ctx context.Context, resp *postgresflex.InstanceResponse, model *
 	model.Replicas = types.Int64PointerValue(instance.Replicas)
 	model.Storage = storageObject
 	model.Version = types.StringPointerValue(instance.Version)
+	model.Status = types.StringPointerValue(instance.Status)
+	model.Options = optionsMap
 	return nil
 }
 
+// mapOptions maps the instance options returned by the API. If options are set in the model, only their keys are kept,
+// so that options set by the API itself don't cause a diff.
+func mapOptions(ctx context.Context, instanceOptions *map[string]string, modelOptions types.Map) (types.Map, error) {
+	if instanceOptions == nil || len(*instanceOptions) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+
+	options := *instanceOptions
+	if !(modelOptions.IsNull() || modelOptions.IsUnknown()) {
+		options = map[string]string{}
+		for key := range modelOptions.Elements() {
+			if value, ok := (*instanceOptions)[key]; ok {
+				options[key] = value
+			}
+		}
+	}
+	return conversion.ToTerraformStringMap(ctx, options)
+}
+
 func toCreatePayload(model *Model, acl []string, flavor *flavorModel, storage *storageModel) (*postgresflex.CreateInstancePayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -608,6 +727,10 @@ func toCreatePayload(model *Model, acl []string, flavor *flavorModel, storage *s
 	if storage == nil {
 		return nil, fmt.Errorf("nil storage")
 	}
+	options, err := conversion.ToOptStringMap(model.Options.Elements())
+	if err != nil {
+		return nil, fmt.Errorf("converting options: %w", err)
+	}
 
 	return &postgresflex.CreateInstancePayload{
 		Acl: &postgresflex.ACL{
@@ -616,6 +739,7 @@ func toCreatePayload(model *Model, acl []string, flavor *flavorModel, storage *s
 		BackupSchedule: conversion.StringValueToPointer(model.BackupSchedule),
 		FlavorId:       conversion.StringValueToPointer(flavor.Id),
 		Name:           conversion.StringValueToPointer(model.Name),
+		Options:        options,
 		Replicas:       conversion.Int64ValueToPointer(model.Replicas),
 		Storage: &postgresflex.Storage{
 			Class: conversion.StringValueToPointer(storage.Class),
@@ -638,6 +762,10 @@ func toUpdatePayload(model *Model, acl []string, flavor *flavorModel, storage *s
 	if storage == nil {
 		return nil, fmt.Errorf("nil storage")
 	}
+	options, err := conversion.ToOptStringMap(model.Options.Elements())
+	if err != nil {
+		return nil, fmt.Errorf("converting options: %w", err)
+	}
 
 	return &postgresflex.PartialUpdateInstancePayload{
 		Acl: &postgresflex.ACL{
@@ -646,6 +774,7 @@ func toUpdatePayload(model *Model, acl []string, flavor *flavorModel, storage *s
 		BackupSchedule: conversion.StringValueToPointer(model.BackupSchedule),
 		FlavorId:       conversion.StringValueToPointer(flavor.Id),
 		Name:           conversion.StringValueToPointer(model.Name),
+		Options:        options,
 		Replicas:       conversion.Int64ValueToPointer(model.Replicas),
 		Storage: &postgresflex.Storage{
 			Class: conversion.StringValueToPointer(storage.Class),
@@ -702,3 +831,44 @@ func loadFlavorId(ctx context.Context, client postgresFlexClient, model *Model,
 
 	return nil
 }
+
+type postgresFlexInstanceClient interface {
+	GetInstanceExecute(ctx context.Context, projectId, instanceId string) (*postgresflex.InstanceResponse, error)
+}
+
+// updateInstanceWaitHandler waits until the instance is ready and runs with the requested flavor and storage size.
+// Scaling is processed asynchronously, so right after the update request the instance can still report the ready
+// status with its old specs. Waiting only for the ready status would let dependent resources connect to an instance
+// that is about to restart.
+func updateInstanceWaitHandler(ctx context.Context, a postgresFlexInstanceClient, projectId, instanceId string, payload *postgresflex.PartialUpdateInstancePayload) *sdkWait.AsyncActionHandler[postgresflex.InstanceResponse] {
+	handler := sdkWait.New(func() (waitFinished bool, response *postgresflex.InstanceResponse, err error) {
+		s, err := a.GetInstanceExecute(ctx, projectId, instanceId)
+		if err != nil {
+			return false, nil, err
+		}
+		if s == nil || s.Item == nil || s.Item.Id == nil || *s.Item.Id != instanceId || s.Item.Status == nil {
+			return false, nil, nil
+		}
+		switch *s.Item.Status {
+		default:
+			return true, s, fmt.Errorf("instance with id %s has unexpected status %s", instanceId, *s.Item.Status)
+		case wait.InstanceStateEmpty, wait.InstanceStateProgressing:
+			return false, nil, nil
+		case wait.InstanceStateFailed:
+			return true, s, fmt.Errorf("update failed for instance with id %s", instanceId)
+		case wait.InstanceStateSuccess:
+			if payload == nil {
+				return true, s, nil
+			}
+			if payload.FlavorId != nil && (s.Item.Flavor == nil || s.Item.Flavor.Id == nil || *s.Item.Flavor.Id != *payload.FlavorId) {
+				return false, nil, nil
+			}
+			if payload.Storage != nil && payload.Storage.Size != nil && (s.Item.Storage == nil || s.Item.Storage.Size == nil || *s.Item.Storage.Size != *payload.Storage.Size) {
+				return false, nil, nil
+			}
+			return true, s, nil
+		}
+	})
+	handler.SetTimeout(45 * time.Minute)
+	return handler
+}
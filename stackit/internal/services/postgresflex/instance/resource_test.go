@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -48,6 +49,7 @@ func TestMapFields(t *testing.T) {
 			&storageModel{},
 			Model{
 				Id:             types.StringValue("pid,iid"),
+				ImportId:       types.StringValue("pid,iid"),
 				InstanceId:     types.StringValue("iid"),
 				ProjectId:      types.StringValue("pid"),
 				Name:           types.StringNull(),
@@ -65,6 +67,7 @@ func TestMapFields(t *testing.T) {
 					"size":  types.Int64Null(),
 				}),
 				Version: types.StringNull(),
+				Options: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -90,8 +93,11 @@ func TestMapFields(t *testing.T) {
 						Id:          utils.Ptr("flavor_id"),
 						Memory:      utils.Ptr(int64(34)),
 					},
-					Id:       utils.Ptr("iid"),
-					Name:     utils.Ptr("name"),
+					Id:   utils.Ptr("iid"),
+					Name: utils.Ptr("name"),
+					Options: &map[string]string{
+						"key": "value",
+					},
 					Replicas: utils.Ptr(int64(56)),
 					Status:   utils.Ptr("status"),
 					Storage: &postgresflex.Storage{
@@ -105,6 +111,7 @@ func TestMapFields(t *testing.T) {
 			&storageModel{},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -126,6 +133,10 @@ func TestMapFields(t *testing.T) {
 					"size":  types.Int64Value(78),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
+				Options: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
 			},
 			true,
 		},
@@ -164,6 +175,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -185,6 +197,8 @@ func TestMapFields(t *testing.T) {
 					"size":  types.Int64Value(78),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
+				Options: types.MapNull(types.StringType),
 			},
 			true,
 		},
@@ -228,6 +242,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -249,6 +264,53 @@ func TestMapFields(t *testing.T) {
 					"size":  types.Int64Value(78),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
+				Options: types.MapNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"options_not_in_state",
+			Model{
+				InstanceId: types.StringValue("iid"),
+				ProjectId:  types.StringValue("pid"),
+				Options: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+			},
+			&postgresflex.InstanceResponse{
+				Item: &postgresflex.Instance{
+					Options: &map[string]string{
+						"key":      "new-value",
+						"otherKey": "value",
+					},
+				},
+			},
+			&flavorModel{},
+			&storageModel{},
+			Model{
+				Id:             types.StringValue("pid,iid"),
+				ImportId:       types.StringValue("pid,iid"),
+				InstanceId:     types.StringValue("iid"),
+				ProjectId:      types.StringValue("pid"),
+				Name:           types.StringNull(),
+				ACL:            types.ListNull(types.StringType),
+				BackupSchedule: types.StringNull(),
+				Flavor: types.ObjectValueMust(flavorTypes, map[string]attr.Value{
+					"id":          types.StringNull(),
+					"description": types.StringNull(),
+					"cpu":         types.Int64Null(),
+					"ram":         types.Int64Null(),
+				}),
+				Replicas: types.Int64Null(),
+				Storage: types.ObjectValueMust(storageTypes, map[string]attr.Value{
+					"class": types.StringNull(),
+					"size":  types.Int64Null(),
+				}),
+				Version: types.StringNull(),
+				Options: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("new-value"),
+				}),
 			},
 			true,
 		},
@@ -327,6 +389,9 @@ func TestToCreatePayload(t *testing.T) {
 				Name:           types.StringValue("name"),
 				Replicas:       types.Int64Value(12),
 				Version:        types.StringValue("version"),
+				Options: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
 			},
 			[]string{
 				"ip_1",
@@ -349,7 +414,10 @@ func TestToCreatePayload(t *testing.T) {
 				BackupSchedule: utils.Ptr("schedule"),
 				FlavorId:       utils.Ptr("flavor_id"),
 				Name:           utils.Ptr("name"),
-				Replicas:       utils.Ptr(int64(12)),
+				Options: &map[string]string{
+					"key": "value",
+				},
+				Replicas: utils.Ptr(int64(12)),
 				Storage: &postgresflex.Storage{
 					Class: utils.Ptr("class"),
 					Size:  utils.Ptr(int64(34)),
@@ -481,6 +549,9 @@ func TestToUpdatePayload(t *testing.T) {
 				Name:           types.StringValue("name"),
 				Replicas:       types.Int64Value(12),
 				Version:        types.StringValue("version"),
+				Options: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
 			},
 			[]string{
 				"ip_1",
@@ -503,7 +574,10 @@ func TestToUpdatePayload(t *testing.T) {
 				BackupSchedule: utils.Ptr("schedule"),
 				FlavorId:       utils.Ptr("flavor_id"),
 				Name:           utils.Ptr("name"),
-				Replicas:       utils.Ptr(int64(12)),
+				Options: &map[string]string{
+					"key": "value",
+				},
+				Replicas: utils.Ptr(int64(12)),
 				Storage: &postgresflex.Storage{
 					Class: utils.Ptr("class"),
 					Size:  utils.Ptr(int64(34)),
@@ -756,3 +830,112 @@ func TestLoadFlavorId(t *testing.T) {
 		})
 	}
 }
+
+type postgresFlexInstanceClientMocked struct {
+	getInstanceResps []*postgresflex.InstanceResponse
+	calls            int
+}
+
+func (c *postgresFlexInstanceClientMocked) GetInstanceExecute(_ context.Context, _, _ string) (*postgresflex.InstanceResponse, error) {
+	if c.calls >= len(c.getInstanceResps) {
+		return nil, fmt.Errorf("no more responses")
+	}
+	resp := c.getInstanceResps[c.calls]
+	c.calls++
+	return resp, nil
+}
+
+func TestUpdateInstanceWaitHandler(t *testing.T) {
+	instance := func(status, flavorId string, storageSize int64) *postgresflex.InstanceResponse {
+		return &postgresflex.InstanceResponse{
+			Item: &postgresflex.Instance{
+				Id:      utils.Ptr("iid"),
+				Status:  utils.Ptr(status),
+				Flavor:  &postgresflex.Flavor{Id: utils.Ptr(flavorId)},
+				Storage: &postgresflex.Storage{Size: utils.Ptr(storageSize)},
+			},
+		}
+	}
+	payload := &postgresflex.PartialUpdateInstancePayload{
+		FlavorId: utils.Ptr("new-flavor"),
+		Storage:  &postgresflex.Storage{Size: utils.Ptr(int64(20))},
+	}
+
+	tests := []struct {
+		description   string
+		payload       *postgresflex.PartialUpdateInstancePayload
+		responses     []*postgresflex.InstanceResponse
+		expectedCalls int
+		isValid       bool
+	}{
+		{
+			"ready_with_new_specs",
+			payload,
+			[]*postgresflex.InstanceResponse{
+				instance("Ready", "new-flavor", 20),
+			},
+			1,
+			true,
+		},
+		{
+			"ready_with_old_specs_before_progressing",
+			payload,
+			[]*postgresflex.InstanceResponse{
+				instance("Ready", "old-flavor", 10),
+				instance("Progressing", "old-flavor", 10),
+				instance("Ready", "new-flavor", 10),
+				instance("Ready", "new-flavor", 20),
+			},
+			4,
+			true,
+		},
+		{
+			"no_specs_in_payload",
+			&postgresflex.PartialUpdateInstancePayload{},
+			[]*postgresflex.InstanceResponse{
+				instance("Progressing", "old-flavor", 10),
+				instance("Ready", "old-flavor", 10),
+			},
+			2,
+			true,
+		},
+		{
+			"failed",
+			payload,
+			[]*postgresflex.InstanceResponse{
+				instance("Progressing", "old-flavor", 10),
+				instance("Failure", "old-flavor", 10),
+			},
+			2,
+			false,
+		},
+		{
+			"unexpected_status",
+			payload,
+			[]*postgresflex.InstanceResponse{
+				instance("Unknown", "old-flavor", 10),
+			},
+			1,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &postgresFlexInstanceClientMocked{
+				getInstanceResps: tt.responses,
+			}
+			handler := updateInstanceWaitHandler(context.Background(), client, "pid", "iid", tt.payload)
+			handler.SetThrottle(time.Millisecond)
+			_, err := handler.WaitWithContext(context.Background())
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if client.calls != tt.expectedCalls {
+				t.Fatalf("Expected %d calls, got %d", tt.expectedCalls, client.calls)
+			}
+		})
+	}
+}
@@ -67,12 +67,12 @@ func (r *userDataSource) Configure(ctx context.Context, req datasource.Configure
 	var apiClient *postgresflex.APIClient
 	var err error
 	if providerData.PostgresFlexCustomEndpoint != "" {
-		apiClient, err = postgresflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.PostgresFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = postgresflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, postgresflex.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
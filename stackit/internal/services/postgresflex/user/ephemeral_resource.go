@@ -0,0 +1,394 @@
+package postgresflex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &userEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &userEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &userEphemeralResource{}
+)
+
+// Key of the private data in which the IDs of the user are passed from Open to Close
+const ephemeralUserPrivateKey = "user"
+
+type EphemeralModel struct {
+	Id         types.String `tfsdk:"id"`
+	UserId     types.String `tfsdk:"user_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	Username   types.String `tfsdk:"username"`
+	Roles      types.Set    `tfsdk:"roles"`
+	Password   types.String `tfsdk:"password"`
+	Host       types.String `tfsdk:"host"`
+	Port       types.Int64  `tfsdk:"port"`
+	Uri        types.String `tfsdk:"uri"`
+}
+
+// ephemeralUserIds holds the IDs needed to delete the user once it is no longer needed.
+type ephemeralUserIds struct {
+	ProjectId  string `json:"project_id"`
+	InstanceId string `json:"instance_id"`
+	UserId     string `json:"user_id"`
+}
+
+// NewUserEphemeralResource is a helper function to simplify the provider implementation.
+func NewUserEphemeralResource() ephemeral.EphemeralResource {
+	return &userEphemeralResource{}
+}
+
+// userEphemeralResource is the ephemeral resource implementation.
+type userEphemeralResource struct {
+	client       *postgresflex.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *userEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresflex_user"
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *userEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *postgresflex.APIClient
+	var err error
+	if r.providerData.PostgresFlexCustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.PostgresFlexCustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&r.providerData, postgresflex.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the ephemeral resource configuration", err))
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "Postgres Flex user client configured")
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *userEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	rolesOptions := []string{"login", "createdb"}
+
+	descriptions := map[string]string{
+		"main":        "Postgres Flex ephemeral user schema. Creates a short-lived user which is deleted as soon as Terraform no longer needs it, so that its credentials are never persisted in the plan or state. Requires Terraform 1.10 or later. Must have a `region` specified in the provider configuration.",
+		"id":          "Terraform's internal ephemeral resource ID. It is structured as \"`project_id`,`instance_id`,`user_id`\".",
+		"user_id":     "User ID.",
+		"instance_id": "ID of the PostgresFlex instance.",
+		"project_id":  "STACKIT project ID to which the instance is associated. If not defined, the provider `default_project_id` is used.",
+		"username":    "Username of the user.",
+		"roles":       "Database access levels for the user. " + utils.SupportedValuesDocumentation(rolesOptions),
+		"password":    "Password of the user.",
+		"host":        "Host of the PostgresFlex instance.",
+		"port":        "Port of the PostgresFlex instance.",
+		"uri":         "Connection URI of the user.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: descriptions["user_id"],
+				Computed:    true,
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Optional:    true,
+				// must be computed to allow for setting the default project ID from the provider
+				Computed: true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: descriptions["username"],
+				Required:    true,
+			},
+			"roles": schema.SetAttribute{
+				Description: descriptions["roles"],
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(rolesOptions...),
+					),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: descriptions["password"],
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"host": schema.StringAttribute{
+				Description: descriptions["host"],
+				Computed:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: descriptions["port"],
+				Computed:    true,
+			},
+			"uri": schema.StringAttribute{
+				Description: descriptions["uri"],
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open creates the user and sets its credentials in the result.
+func (r *userEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) { // nolint:gocritic // function signature required by Terraform
+	var model EphemeralModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.ProjectId.IsNull() {
+		if r.providerData.DefaultProjectId == "" {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating user", "Either project_id or the provider default_project_id must be set")
+			return
+		}
+		model.ProjectId = types.StringValue(r.providerData.DefaultProjectId)
+	}
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	var roles []string
+	if !(model.Roles.IsNull() || model.Roles.IsUnknown()) {
+		diags = model.Roles.ElementsAs(ctx, &roles, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Generate API request body from model
+	payload, err := toEphemeralCreatePayload(&model, roles)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating user", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	// Create new user
+	userResp, err := r.client.CreateUser(ctx, projectId, instanceId).CreateUserPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating user", err, req.Config)
+		return
+	}
+	if userResp == nil || userResp.Item == nil || userResp.Item.Id == nil || *userResp.Item.Id == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating user", "API didn't return user ID. A user might have been created")
+		return
+	}
+	userId := *userResp.Item.Id
+	ctx = tflog.SetField(ctx, "user_id", userId)
+	ids := ephemeralUserIds{
+		ProjectId:  projectId,
+		InstanceId: instanceId,
+		UserId:     userId,
+	}
+
+	// Terraform doesn't call Close if Open fails, so the user is deleted right away if its credentials can't be returned
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.deleteUser(ctx, ids)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting user", fmt.Sprintf("The user was created, but couldn't be deleted after the error above, delete it manually: %v", err))
+			return
+		}
+		tflog.Info(ctx, "Postgres Flex ephemeral user deleted after failing to open it")
+	}()
+
+	// Keep the IDs, so that the user can be deleted in Close
+	privateData, err := json.Marshal(ids)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating user", fmt.Sprintf("Encoding private data: %v", err))
+		return
+	}
+	diags = resp.Private.SetKey(ctx, ephemeralUserPrivateKey, privateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema
+	err = mapEphemeralFields(userResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating user", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.Result.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex ephemeral user created")
+}
+
+// Close deletes the user once Terraform no longer needs its credentials.
+func (r *userEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, ephemeralUserPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if privateData == nil {
+		// Open failed before the user was created
+		return
+	}
+
+	var ids ephemeralUserIds
+	err := json.Unmarshal(privateData, &ids)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting user", fmt.Sprintf("Decoding private data: %v", err))
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", ids.ProjectId)
+	ctx = tflog.SetField(ctx, "instance_id", ids.InstanceId)
+	ctx = tflog.SetField(ctx, "user_id", ids.UserId)
+
+	err = r.deleteUser(ctx, ids)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting user", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Postgres Flex ephemeral user deleted")
+}
+
+// deleteUser deletes the user and waits for the deletion. A user which is already deleted is ignored.
+func (r *userEphemeralResource) deleteUser(ctx context.Context, ids ephemeralUserIds) error {
+	err := r.client.DeleteUser(ctx, ids.ProjectId, ids.InstanceId, ids.UserId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Postgres Flex ephemeral user already deleted")
+			return nil
+		}
+		return fmt.Errorf("calling API: %w", err)
+	}
+	_, err = utils.Wait(ctx, wait.DeleteUserWaitHandler(ctx, r.client, ids.ProjectId, ids.InstanceId, ids.UserId), "PostgreSQL Flex ephemeral user deletion")
+	if err != nil {
+		return fmt.Errorf("user deletion waiting: %w", err)
+	}
+	return nil
+}
+
+func mapEphemeralFields(userResp *postgresflex.CreateUserResponse, model *EphemeralModel) error {
+	if userResp == nil || userResp.Item == nil {
+		return fmt.Errorf("response is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	user := userResp.Item
+
+	if user.Id == nil {
+		return fmt.Errorf("user id not present")
+	}
+	userId := *user.Id
+	idParts := []string{
+		model.ProjectId.ValueString(),
+		model.InstanceId.ValueString(),
+		userId,
+	}
+	model.Id = types.StringValue(
+		strings.Join(idParts, core.Separator),
+	)
+	model.UserId = types.StringValue(userId)
+	model.Username = types.StringPointerValue(user.Username)
+
+	if user.Password == nil {
+		return fmt.Errorf("user password not present")
+	}
+	model.Password = types.StringValue(*user.Password)
+
+	if user.Roles == nil {
+		model.Roles = types.SetNull(types.StringType)
+	} else {
+		roles := []attr.Value{}
+		for _, role := range *user.Roles {
+			roles = append(roles, types.StringValue(role))
+		}
+		rolesSet, diags := types.SetValue(types.StringType, roles)
+		if diags.HasError() {
+			return fmt.Errorf("mapping roles: %w", core.DiagsToError(diags))
+		}
+		model.Roles = rolesSet
+	}
+	model.Host = types.StringPointerValue(user.Host)
+	model.Port = types.Int64PointerValue(user.Port)
+	model.Uri = types.StringPointerValue(user.Uri)
+	return nil
+}
+
+func toEphemeralCreatePayload(model *EphemeralModel, roles []string) (*postgresflex.CreateUserPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if roles == nil {
+		return nil, fmt.Errorf("nil roles")
+	}
+
+	return &postgresflex.CreateUserPayload{
+		Roles:    &roles,
+		Username: conversion.StringValueToPointer(model.Username),
+	}, nil
+}
@@ -0,0 +1,312 @@
+package postgresflex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+func TestMapEphemeralFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *postgresflex.CreateUserResponse
+		expected    EphemeralModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&postgresflex.CreateUserResponse{
+				Item: &postgresflex.User{
+					Id:       utils.Ptr("uid"),
+					Password: utils.Ptr(""),
+				},
+			},
+			EphemeralModel{
+				Id:         types.StringValue("pid,iid,uid"),
+				UserId:     types.StringValue("uid"),
+				InstanceId: types.StringValue("iid"),
+				ProjectId:  types.StringValue("pid"),
+				Username:   types.StringNull(),
+				Roles:      types.SetNull(types.StringType),
+				Password:   types.StringValue(""),
+				Host:       types.StringNull(),
+				Port:       types.Int64Null(),
+				Uri:        types.StringNull(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&postgresflex.CreateUserResponse{
+				Item: &postgresflex.User{
+					Id:       utils.Ptr("uid"),
+					Roles:    &[]string{"role_1", "role_2"},
+					Username: utils.Ptr("username"),
+					Password: utils.Ptr("password"),
+					Host:     utils.Ptr("host"),
+					Port:     utils.Ptr(int64(1234)),
+					Uri:      utils.Ptr("uri"),
+				},
+			},
+			EphemeralModel{
+				Id:         types.StringValue("pid,iid,uid"),
+				UserId:     types.StringValue("uid"),
+				InstanceId: types.StringValue("iid"),
+				ProjectId:  types.StringValue("pid"),
+				Username:   types.StringValue("username"),
+				Roles: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("role_1"),
+					types.StringValue("role_2"),
+				}),
+				Password: types.StringValue("password"),
+				Host:     types.StringValue("host"),
+				Port:     types.Int64Value(1234),
+				Uri:      types.StringValue("uri"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			EphemeralModel{},
+			false,
+		},
+		{
+			"nil_response_2",
+			&postgresflex.CreateUserResponse{},
+			EphemeralModel{},
+			false,
+		},
+		{
+			"no_resource_id",
+			&postgresflex.CreateUserResponse{
+				Item: &postgresflex.User{},
+			},
+			EphemeralModel{},
+			false,
+		},
+		{
+			"no_password",
+			&postgresflex.CreateUserResponse{
+				Item: &postgresflex.User{
+					Id: utils.Ptr("uid"),
+				},
+			},
+			EphemeralModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &EphemeralModel{
+				ProjectId:  tt.expected.ProjectId,
+				InstanceId: tt.expected.InstanceId,
+			}
+			err := mapEphemeralFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToEphemeralCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *EphemeralModel
+		inputRoles  []string
+		expected    *postgresflex.CreateUserPayload
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&EphemeralModel{
+				Username: types.StringValue("username"),
+			},
+			[]string{"role_1", "role_2"},
+			&postgresflex.CreateUserPayload{
+				Roles:    &[]string{"role_1", "role_2"},
+				Username: utils.Ptr("username"),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			[]string{},
+			nil,
+			false,
+		},
+		{
+			"nil_roles",
+			&EphemeralModel{},
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toEphemeralCreatePayload(tt.input, tt.inputRoles)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	tests := []struct {
+		description     string
+		createResp      postgresflex.CreateUserResponse
+		deleteSucceeds  bool
+		expectedDeleted bool
+		isValid         bool
+	}{
+		{
+			"user_opened",
+			postgresflex.CreateUserResponse{
+				Item: &postgresflex.User{
+					Id:       utils.Ptr("uid"),
+					Username: utils.Ptr("username"),
+					Password: utils.Ptr("password"),
+					Roles:    &[]string{"login"},
+				},
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"user_deleted_on_error",
+			postgresflex.CreateUserResponse{
+				Item: &postgresflex.User{
+					Id:       utils.Ptr("uid"),
+					Username: utils.Ptr("username"),
+					Roles:    &[]string{"login"},
+				},
+			},
+			true,
+			true,
+			false,
+		},
+		{
+			"user_deletion_fails",
+			postgresflex.CreateUserResponse{
+				Item: &postgresflex.User{
+					Id:       utils.Ptr("uid"),
+					Username: utils.Ptr("username"),
+					Roles:    &[]string{"login"},
+				},
+			},
+			false,
+			true,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			deleted := false
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					w.Header().Set("Content-Type", "application/json")
+					err := json.NewEncoder(w).Encode(tt.createResp)
+					if err != nil {
+						t.Errorf("Failed to write response: %v", err)
+					}
+				case http.MethodDelete:
+					deleted = true
+					if !tt.deleteSucceeds {
+						w.WriteHeader(http.StatusInternalServerError)
+					}
+				case http.MethodGet:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			mockedServer := httptest.NewServer(handler)
+			defer mockedServer.Close()
+			client, err := postgresflex.NewAPIClient(
+				config.WithEndpoint(mockedServer.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			r := &userEphemeralResource{client: client}
+
+			schemaResp := ephemeral.SchemaResponse{}
+			r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+			schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+			req := ephemeral.OpenRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":          tftypes.NewValue(tftypes.String, nil),
+						"user_id":     tftypes.NewValue(tftypes.String, nil),
+						"instance_id": tftypes.NewValue(tftypes.String, "iid"),
+						"project_id":  tftypes.NewValue(tftypes.String, "pid"),
+						"username":    tftypes.NewValue(tftypes.String, "username"),
+						"roles":       tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "login")}),
+						"password":    tftypes.NewValue(tftypes.String, nil),
+						"host":        tftypes.NewValue(tftypes.String, nil),
+						"port":        tftypes.NewValue(tftypes.Number, nil),
+						"uri":         tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			}
+			resp := ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaType, nil),
+				},
+			}
+			// The private data type is internal to the framework, so it is initialized through reflection
+			private := reflect.ValueOf(&resp).Elem().FieldByName("Private")
+			private.Set(reflect.New(private.Type().Elem()))
+
+			r.Open(ctx, req, &resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			if deleted != tt.expectedDeleted {
+				t.Fatalf("User deleted: expected %t, got %t", tt.expectedDeleted, deleted)
+			}
+		})
+	}
+}
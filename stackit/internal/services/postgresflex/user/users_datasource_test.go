@@ -0,0 +1,98 @@
+package postgresflex
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex"
+)
+
+func TestMapUsersDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *postgresflex.ListUsersResponse
+		expected    usersDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_users",
+			&postgresflex.ListUsersResponse{},
+			usersDataSourceModel{
+				Id:         types.StringValue("pid,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Items:      []usersDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&postgresflex.ListUsersResponse{
+				Items: &[]postgresflex.ListUsersResponseItem{
+					{
+						Id:       utils.Ptr("uid"),
+						Username: utils.Ptr("username"),
+					},
+					{
+						Id: utils.Ptr("uid-2"),
+					},
+				},
+			},
+			usersDataSourceModel{
+				Id:         types.StringValue("pid,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Items: []usersDataSourceItemModel{
+					{
+						UserId:   types.StringValue("uid"),
+						Username: types.StringValue("username"),
+					},
+					{
+						UserId:   types.StringValue("uid-2"),
+						Username: types.StringNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_user_id",
+			&postgresflex.ListUsersResponse{
+				Items: &[]postgresflex.ListUsersResponseItem{
+					{},
+				},
+			},
+			usersDataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			usersDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &usersDataSourceModel{
+				ProjectId:  tt.expected.ProjectId,
+				InstanceId: tt.expected.InstanceId,
+			}
+			err := mapUsersDataSourceFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
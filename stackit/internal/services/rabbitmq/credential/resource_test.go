@@ -31,6 +31,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -81,6 +82,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -158,6 +160,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -211,6 +214,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -68,6 +68,7 @@ func TestMapFields(t *testing.T) {
 			&rabbitmq.Instance{},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringNull(),
@@ -77,6 +78,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringNull(),
 				ImageUrl:           types.StringNull(),
 				CfOrganizationGuid: types.StringNull(),
+				Status:             types.StringNull(),
 				Parameters:         types.ObjectNull(parametersTypes),
 			},
 			true,
@@ -92,6 +94,7 @@ func TestMapFields(t *testing.T) {
 				InstanceId:         utils.Ptr("iid"),
 				Name:               utils.Ptr("name"),
 				CfOrganizationGuid: utils.Ptr("org"),
+				Status:             utils.Ptr("active"),
 				Parameters: &map[string]interface{}{
 					"sgw_acl":                "acl",
 					"consumer_timeout":       10,
@@ -110,6 +113,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringValue("plan"),
@@ -119,6 +123,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringValue("dashboard"),
 				ImageUrl:           types.StringValue("image"),
 				CfOrganizationGuid: types.StringValue("org"),
+				Status:             types.StringValue("active"),
 				Parameters:         fixtureModelParameters,
 			},
 			true,
@@ -31,6 +31,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,iid,cid"),
+				ImportId:         types.StringValue("pid,iid,cid"),
 				CredentialId:     types.StringValue("cid"),
 				InstanceId:       types.StringValue("iid"),
 				ProjectId:        types.StringValue("pid"),
@@ -69,6 +70,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -116,6 +118,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:           types.StringValue("pid,iid,cid"),
+				ImportId:     types.StringValue("pid,iid,cid"),
 				CredentialId: types.StringValue("cid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -155,6 +158,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:               types.StringValue("pid,iid,cid"),
+				ImportId:         types.StringValue("pid,iid,cid"),
 				CredentialId:     types.StringValue("cid"),
 				InstanceId:       types.StringValue("iid"),
 				ProjectId:        types.StringValue("pid"),
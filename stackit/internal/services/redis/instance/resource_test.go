@@ -80,6 +80,7 @@ func TestMapFields(t *testing.T) {
 			&redis.Instance{},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringNull(),
@@ -89,6 +90,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringNull(),
 				ImageUrl:           types.StringNull(),
 				CfOrganizationGuid: types.StringNull(),
+				Status:             types.StringNull(),
 				Parameters:         types.ObjectNull(parametersTypes),
 			},
 			true,
@@ -104,6 +106,7 @@ func TestMapFields(t *testing.T) {
 				InstanceId:         utils.Ptr("iid"),
 				Name:               utils.Ptr("name"),
 				CfOrganizationGuid: utils.Ptr("org"),
+				Status:             utils.Ptr("active"),
 				Parameters: &map[string]interface{}{
 					"sgw_acl":                 "acl",
 					"down-after-milliseconds": int64(10),
@@ -131,6 +134,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                 types.StringValue("pid,iid"),
+				ImportId:           types.StringValue("pid,iid"),
 				InstanceId:         types.StringValue("iid"),
 				ProjectId:          types.StringValue("pid"),
 				PlanId:             types.StringValue("plan"),
@@ -140,6 +144,7 @@ func TestMapFields(t *testing.T) {
 				DashboardUrl:       types.StringValue("dashboard"),
 				ImageUrl:           types.StringValue("image"),
 				CfOrganizationGuid: types.StringValue("org"),
+				Status:             types.StringValue("active"),
 				Parameters:         fixtureModelParameters,
 			},
 			true,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
@@ -22,6 +23,8 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
 	"github.com/stackitcloud/stackit-sdk-go/services/authorization"
 	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement/wait"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -29,6 +32,12 @@ var (
 	_ datasource.DataSource = &projectDataSource{}
 )
 
+// DataSourceModel maps the data source schema data.
+type DataSourceModel struct {
+	Model
+	EnabledServices types.Map `tfsdk:"enabled_services"`
+}
+
 // NewProjectDataSource is a helper function to simplify the provider implementation.
 func NewProjectDataSource() datasource.DataSource {
 	return &projectDataSource{}
@@ -38,6 +47,7 @@ func NewProjectDataSource() datasource.DataSource {
 type projectDataSource struct {
 	resourceManagerClient *resourcemanager.APIClient
 	membershipClient      *authorization.APIClient
+	enablementClient      *serviceenablement.APIClient
 }
 
 // Metadata returns the data source type name.
@@ -60,12 +70,12 @@ func (d *projectDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 
 	if providerData.ResourceManagerCustomEndpoint != "" {
-		rmClient, err = resourcemanager.NewAPIClient(
+		rmClient, err = core.NewAPIClient(&providerData, resourcemanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ResourceManagerCustomEndpoint),
 		)
 	} else {
-		rmClient, err = resourcemanager.NewAPIClient(
+		rmClient, err = core.NewAPIClient(&providerData, resourcemanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 		)
 	}
@@ -77,12 +87,12 @@ func (d *projectDataSource) Configure(ctx context.Context, req datasource.Config
 	var aClient *authorization.APIClient
 	if providerData.AuthorizationCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "authorization_custom_endpoint", providerData.AuthorizationCustomEndpoint)
-		aClient, err = authorization.NewAPIClient(
+		aClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.AuthorizationCustomEndpoint),
 		)
 	} else {
-		aClient, err = authorization.NewAPIClient(
+		aClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 		)
 	}
@@ -92,8 +102,26 @@ func (d *projectDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
+	var enablementClient *serviceenablement.APIClient
+	if providerData.ServiceEnablementCustomEndpoint != "" {
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.ServiceEnablementCustomEndpoint),
+		)
+	} else {
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithRegion(providerData.Region),
+		)
+	}
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring Service Enablement API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
 	d.resourceManagerClient = rmClient
 	d.membershipClient = aClient
+	d.enablementClient = enablementClient
 	tflog.Info(ctx, "Resource Manager project client configured")
 }
 
@@ -102,6 +130,7 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	descriptions := map[string]string{
 		"main":                        "Resource Manager project data source schema. To identify the project, you need to provider either project_id or container_id. If you provide both, project_id will be used.",
 		"id":                          "Terraform's internal data source. ID. It is structured as \"`container_id`\".",
+		"import_id":                   "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"project_id":                  "Project UUID identifier. This is the ID that can be used in most of the other resources to identify the project.",
 		"container_id":                "Project container ID. Globally unique, user-friendly identifier.",
 		"parent_container_id":         "Parent resource identifier. Both container ID (user-friendly) and UUID are supported",
@@ -111,6 +140,7 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		"members":                     "The members assigned to the project. At least one subject needs to be a user, and not a client or service account. This value is only considered during creation. Changing it afterwards will have no effect.",
 		"members.role":                fmt.Sprintf("The role of the member in the project. Legacy roles (%s) are not supported.", strings.Join(utils.QuoteValues(utils.LegacyProjectRoles), ", ")),
 		"members.subject":             "Unique identifier of the user, service account or client. This is usually the email address for users or service accounts, and the name in case of clients.",
+		"enabled_services":            "Map of the services of the project, keyed by service ID (e.g. `cloud.stackit.ske`). The value is `true` if the service is enabled in the project. Can be used to check prerequisites, e.g. that SKE is enabled, before planning dependent resources.",
 		"members_deprecation_message": "The \"members\" field has been deprecated in favor of the \"owner_email\" field. Please use the \"owner_email\" field to assign the owner role to a user.",
 	}
 
@@ -121,6 +151,10 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: descriptions["project_id"],
 				Optional:    true,
@@ -192,13 +226,18 @@ func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					},
 				},
 			},
+			"enabled_services": schema.MapAttribute{
+				Description: descriptions["enabled_services"],
+				ElementType: types.BoolType,
+				Computed:    true,
+			},
 		},
 	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model DataSourceModel
 	diags := req.Config.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -232,12 +271,24 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	err = mapProjectFields(ctx, projectResp, &model, &resp.State)
+	err = mapProjectFields(ctx, projectResp, &model.Model, &resp.State)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading project", fmt.Sprintf("Processing API response: %v", err))
 		return
 	}
 
+	model.EnabledServices = types.MapNull(types.BoolType)
+	services, err := listServiceStatuses(ctx, d.enablementClient, model.ProjectId.ValueString())
+	if err != nil {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Error reading enabled services of project", fmt.Sprintf("Calling API: %v. The \"enabled_services\" attribute will be empty.", err))
+	} else {
+		model.EnabledServices, err = mapEnabledServices(services)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading project", fmt.Sprintf("Processing service enablement API response: %v", err))
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -245,3 +296,42 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 	tflog.Info(ctx, "Resource Manager project read")
 }
+
+// listServiceStatuses returns the status of all services of the project, following the pagination of the API
+func listServiceStatuses(ctx context.Context, client *serviceenablement.APIClient, projectId string) ([]serviceenablement.ServiceStatus, error) {
+	services := []serviceenablement.ServiceStatus{}
+	var cursor string
+	for {
+		request := client.ListServiceStatus(ctx, projectId)
+		if cursor != "" {
+			request = request.Cursor(cursor)
+		}
+		servicesResp, err := request.Execute()
+		if err != nil {
+			return nil, err
+		}
+		if servicesResp.Items != nil {
+			services = append(services, *servicesResp.Items...)
+		}
+		if servicesResp.NextCursor == nil || *servicesResp.NextCursor == "" || *servicesResp.NextCursor == cursor {
+			return services, nil
+		}
+		cursor = *servicesResp.NextCursor
+	}
+}
+
+func mapEnabledServices(services []serviceenablement.ServiceStatus) (types.Map, error) {
+	enabledServices := map[string]attr.Value{}
+	for i := range services {
+		service := &services[i]
+		if service.ServiceId == nil {
+			return types.MapNull(types.BoolType), fmt.Errorf("service id not present")
+		}
+		enabledServices[*service.ServiceId] = types.BoolValue(service.State != nil && *service.State == wait.ServiceStateEnabled)
+	}
+	enabledServicesTF, diags := types.MapValue(types.BoolType, enabledServices)
+	if diags.HasError() {
+		return types.MapNull(types.BoolType), fmt.Errorf("converting enabled services: %w", core.DiagsToError(diags))
+	}
+	return enabledServicesTF, nil
+}
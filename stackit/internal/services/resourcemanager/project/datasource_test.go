@@ -0,0 +1,81 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/serviceenablement"
+)
+
+func TestMapEnabledServices(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []serviceenablement.ServiceStatus
+		expected    types.Map
+		isValid     bool
+	}{
+		{
+			"no_services",
+			[]serviceenablement.ServiceStatus{},
+			types.MapValueMust(types.BoolType, map[string]attr.Value{}),
+			true,
+		},
+		{
+			"simple_values",
+			[]serviceenablement.ServiceStatus{
+				{
+					ServiceId: utils.Ptr("cloud.stackit.ske"),
+					State:     utils.Ptr("ENABLED"),
+				},
+				{
+					ServiceId: utils.Ptr("cloud.stackit.postgres-flex"),
+					State:     utils.Ptr("ENABLING"),
+				},
+				{
+					ServiceId: utils.Ptr("cloud.stackit.mongodb-flex"),
+					State:     utils.Ptr("DISABLED"),
+				},
+				{
+					ServiceId: utils.Ptr("cloud.stackit.dns"),
+				},
+			},
+			types.MapValueMust(types.BoolType, map[string]attr.Value{
+				"cloud.stackit.ske":           types.BoolValue(true),
+				"cloud.stackit.postgres-flex": types.BoolValue(false),
+				"cloud.stackit.mongodb-flex":  types.BoolValue(false),
+				"cloud.stackit.dns":           types.BoolValue(false),
+			}),
+			true,
+		},
+		{
+			"no_service_id",
+			[]serviceenablement.ServiceStatus{
+				{
+					State: utils.Ptr("ENABLED"),
+				},
+			},
+			types.MapNull(types.BoolType),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapEnabledServices(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -42,6 +42,7 @@ var (
 	_ resource.Resource                = &projectResource{}
 	_ resource.ResourceWithConfigure   = &projectResource{}
 	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.ResourceWithModifyPlan  = &projectResource{}
 )
 
 const (
@@ -51,6 +52,7 @@ const (
 
 type Model struct {
 	Id                types.String `tfsdk:"id"` // needed by TF
+	ImportId          types.String `tfsdk:"import_id"`
 	ProjectId         types.String `tfsdk:"project_id"`
 	ContainerId       types.String `tfsdk:"container_id"`
 	ContainerParentId types.String `tfsdk:"parent_container_id"`
@@ -81,6 +83,7 @@ func NewProjectResource() resource.Resource {
 type projectResource struct {
 	resourceManagerClient *resourcemanager.APIClient
 	authorizationClient   *authorization.APIClient
+	defaultLabels         map[string]string
 }
 
 // Metadata returns the resource type name.
@@ -105,12 +108,12 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 	var err error
 	if providerData.ResourceManagerCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "resourcemanager_custom_endpoint", providerData.ResourceManagerCustomEndpoint)
-		rmClient, err = resourcemanager.NewAPIClient(
+		rmClient, err = core.NewAPIClient(&providerData, resourcemanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ResourceManagerCustomEndpoint),
 		)
 	} else {
-		rmClient, err = resourcemanager.NewAPIClient(
+		rmClient, err = core.NewAPIClient(&providerData, resourcemanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 		)
 	}
@@ -123,12 +126,12 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 	var aClient *authorization.APIClient
 	if providerData.AuthorizationCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "authorization_custom_endpoint", providerData.AuthorizationCustomEndpoint)
-		aClient, err = authorization.NewAPIClient(
+		aClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.AuthorizationCustomEndpoint),
 		)
 	} else {
-		aClient, err = authorization.NewAPIClient(
+		aClient, err = core.NewAPIClient(&providerData, authorization.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 		)
 	}
@@ -140,14 +143,22 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	r.resourceManagerClient = rmClient
 	r.authorizationClient = aClient
+	r.defaultLabels = providerData.DefaultLabels
 	tflog.Info(ctx, "Resource Manager project client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to add the default labels of the provider to the planned labels.
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.ModifyPlanLabels(ctx, r.defaultLabels, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
 		"main":                        "Resource Manager project resource schema. To use this resource, it is required that you set the service account email in the provider configuration.",
 		"id":                          "Terraform's internal resource ID. It is structured as \"`container_id`\".",
+		"import_id":                   "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"project_id":                  "Project UUID identifier. This is the ID that can be used in most of the other resources to identify the project.",
 		"container_id":                "Project container ID. Globally unique, user-friendly identifier.",
 		"parent_container_id":         "Parent resource identifier. Both container ID (user-friendly) and UUID are supported",
@@ -170,6 +181,13 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: descriptions["project_id"],
 				Computed:    true,
@@ -209,6 +227,7 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: descriptions["labels"],
 				ElementType: types.StringType,
 				Optional:    true,
+				Computed:    true,
 				Validators: []validator.Map{
 					mapvalidator.KeysAre(
 						stringvalidator.RegexMatches(
@@ -285,14 +304,14 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create new project
 	createResp, err := r.resourceManagerClient.CreateProject(ctx).CreateProjectPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating project", err, req.Plan)
 		return
 	}
 	respContainerId := *createResp.ContainerId
 
 	// If the request has not been processed yet and the containerId doesnt exist,
 	// the waiter will fail with authentication error, so wait some time before checking the creation
-	waitResp, err := wait.CreateProjectWaitHandler(ctx, r.resourceManagerClient, respContainerId).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.CreateProjectWaitHandler(ctx, r.resourceManagerClient, respContainerId), "project creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -304,7 +323,12 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	membersResp, err := r.authorizationClient.ListMembersExecute(ctx, projectResourceType, *waitResp.ProjectId)
+	// The role assignments of a new project are not always available right away
+	var membersResp *authorization.ListMembersResponse
+	err = utils.ReadAfterWrite(ctx, func() (bool, error) {
+		membersResp, err = r.authorizationClient.ListMembersExecute(ctx, projectResourceType, *waitResp.ProjectId)
+		return err == nil, err
+	})
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project", fmt.Sprintf("Reading members: %v", err))
 		return
@@ -382,7 +406,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update existing project
 	_, err = r.resourceManagerClient.PartialUpdateProject(ctx, containerId).PartialUpdateProjectPayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating project", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating project", err, req.Plan)
 		return
 	}
 
@@ -427,7 +451,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err = wait.DeleteProjectWaitHandler(ctx, r.resourceManagerClient, containerId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteProjectWaitHandler(ctx, r.resourceManagerClient, containerId), "project deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting project", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -505,6 +529,7 @@ func mapProjectFields(ctx context.Context, projectResp *resourcemanager.GetProje
 	}
 
 	model.Id = types.StringValue(containerId)
+	model.ImportId = model.Id
 	model.ProjectId = types.StringValue(projectId)
 	model.ContainerParentId = containerParentIdTF
 	model.ContainerId = types.StringValue(containerId)
@@ -34,6 +34,7 @@ func TestMapProjectFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("cid"),
+				ImportId:          types.StringValue("cid"),
 				ContainerId:       types.StringValue("cid"),
 				ProjectId:         types.StringValue("pid"),
 				ContainerParentId: types.StringNull(),
@@ -61,6 +62,7 @@ func TestMapProjectFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("cid"),
+				ImportId:          types.StringValue("cid"),
 				ContainerId:       types.StringValue("cid"),
 				ProjectId:         types.StringValue("pid"),
 				ContainerParentId: types.StringValue("parent_cid"),
@@ -91,6 +93,7 @@ func TestMapProjectFields(t *testing.T) {
 			},
 			Model{
 				Id:                types.StringValue("cid"),
+				ImportId:          types.StringValue("cid"),
 				ContainerId:       types.StringValue("cid"),
 				ProjectId:         types.StringValue("pid"),
 				ContainerParentId: types.StringValue(testUUID),
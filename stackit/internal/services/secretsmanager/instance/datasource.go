@@ -54,12 +54,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var apiClient *secretsmanager.APIClient
 	var err error
 	if providerData.SecretsManagerCustomEndpoint != "" {
-		apiClient, err = secretsmanager.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, secretsmanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.SecretsManagerCustomEndpoint),
 		)
 	} else {
-		apiClient, err = secretsmanager.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, secretsmanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -77,12 +77,14 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 // Schema defines the schema for the data source.
 func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	descriptions := map[string]string{
-		"main":        "Secrets Manager instance data source schema. Must have a `region` specified in the provider configuration.",
-		"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
-		"instance_id": "ID of the Secrets Manager instance.",
-		"project_id":  "STACKIT project ID to which the instance is associated.",
-		"name":        "Instance name.",
-		"acls":        "The access control list for this instance. Each entry is an IP or IP range that is permitted to access, in CIDR notation",
+		"main":           "Secrets Manager instance data source schema. Must have a `region` specified in the provider configuration.",
+		"id":             "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":      "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+		"instance_id":    "ID of the Secrets Manager instance.",
+		"project_id":     "STACKIT project ID to which the instance is associated.",
+		"name":           "Instance name.",
+		"acls":           "The access control list for this instance. Each entry is an IP or IP range that is permitted to access, in CIDR notation",
+		"secrets_engine": "The secrets engine of the instance, e.g. `kv-v2`. The engine is chosen by the service and cannot be configured.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -92,6 +94,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Required:    true,
@@ -117,6 +123,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"secrets_engine": schema.StringAttribute{
+				Description: descriptions["secrets_engine"],
+				Computed:    true,
+			},
 		},
 	}
 }
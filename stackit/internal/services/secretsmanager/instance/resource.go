@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	internalUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -31,15 +32,18 @@ import (
 var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
 )
 
 type Model struct {
-	Id         types.String `tfsdk:"id"` // needed by TF
-	InstanceId types.String `tfsdk:"instance_id"`
-	ProjectId  types.String `tfsdk:"project_id"`
-	Name       types.String `tfsdk:"name"`
-	ACLs       types.Set    `tfsdk:"acls"`
+	Id            types.String `tfsdk:"id"` // needed by TF
+	ImportId      types.String `tfsdk:"import_id"`
+	InstanceId    types.String `tfsdk:"instance_id"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	Name          types.String `tfsdk:"name"`
+	ACLs          types.Set    `tfsdk:"acls"`
+	SecretsEngine types.String `tfsdk:"secrets_engine"`
 }
 
 // NewInstanceResource is a helper function to simplify the provider implementation.
@@ -49,7 +53,8 @@ func NewInstanceResource() resource.Resource {
 
 // instanceResource is the resource implementation.
 type instanceResource struct {
-	client *secretsmanager.APIClient
+	client       *secretsmanager.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -73,12 +78,12 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var apiClient *secretsmanager.APIClient
 	var err error
 	if providerData.SecretsManagerCustomEndpoint != "" {
-		apiClient, err = secretsmanager.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, secretsmanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.SecretsManagerCustomEndpoint),
 		)
 	} else {
-		apiClient, err = secretsmanager.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, secretsmanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -90,18 +95,27 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	}
 
 	r.client = apiClient
+	r.providerData = providerData
 	tflog.Info(ctx, "Secrets Manager instance client configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	internalUtils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
-		"main":        "Secrets Manager instance resource schema. Must have a `region` specified in the provider configuration.",
-		"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
-		"instance_id": "ID of the Secrets Manager instance.",
-		"project_id":  "STACKIT project ID to which the instance is associated.",
-		"name":        "Instance name.",
-		"acls":        "The access control list for this instance. Each entry is an IP or IP range that is permitted to access, in CIDR notation",
+		"main":           "Secrets Manager instance resource schema. Must have a `region` specified in the provider configuration.",
+		"id":             "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
+		"import_id":      "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+		"instance_id":    "ID of the Secrets Manager instance.",
+		"project_id":     "STACKIT project ID to which the instance is associated.",
+		"name":           "Instance name.",
+		"acls":           "The access control list for this instance. Each entry is an IP or IP range that is permitted to access, in CIDR notation",
+		"secrets_engine": "The secrets engine of the instance, e.g. `kv-v2`. The engine is chosen by the service and cannot be configured.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -114,6 +128,13 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Computed:    true,
@@ -156,6 +177,13 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					),
 				},
 			},
+			"secrets_engine": schema.StringAttribute{
+				Description: descriptions["secrets_engine"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -189,7 +217,7 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId).CreateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating instance", err, req.Plan)
 		return
 	}
 	instanceId := *createResp.Id
@@ -299,7 +327,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 
 	instanceResp, err := r.client.GetInstance(ctx, projectId, instanceId).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating instance", err, req.Plan)
 		return
 	}
 	aclList, err := r.client.ListACLs(ctx, projectId, instanceId).Execute()
@@ -388,8 +416,10 @@ func mapFields(instance *secretsmanager.Instance, aclList *secretsmanager.ListAC
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.InstanceId = types.StringValue(instanceId)
 	model.Name = types.StringPointerValue(instance.Name)
+	model.SecretsEngine = types.StringPointerValue(instance.SecretsEngine)
 
 	err := mapACLs(aclList, model)
 	if err != nil {
@@ -31,18 +31,21 @@ func TestMapFields(t *testing.T) {
 			&secretsmanager.Instance{},
 			&secretsmanager.ListACLsResponse{},
 			Model{
-				Id:         types.StringValue("pid,iid"),
-				InstanceId: types.StringValue("iid"),
-				ProjectId:  types.StringValue("pid"),
-				Name:       types.StringNull(),
-				ACLs:       types.SetNull(types.StringType),
+				Id:            types.StringValue("pid,iid"),
+				ImportId:      types.StringValue("pid,iid"),
+				InstanceId:    types.StringValue("iid"),
+				ProjectId:     types.StringValue("pid"),
+				Name:          types.StringNull(),
+				ACLs:          types.SetNull(types.StringType),
+				SecretsEngine: types.StringNull(),
 			},
 			true,
 		},
 		{
 			"simple_values",
 			&secretsmanager.Instance{
-				Name: utils.Ptr("name"),
+				Name:          utils.Ptr("name"),
+				SecretsEngine: utils.Ptr("kv-v2"),
 			},
 			&secretsmanager.ListACLsResponse{
 				Acls: &[]secretsmanager.ACL{
@@ -62,6 +65,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:         types.StringValue("pid,iid"),
+				ImportId:   types.StringValue("pid,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -70,6 +74,7 @@ func TestMapFields(t *testing.T) {
 					types.StringValue("cidr-2"),
 					types.StringValue("cidr-3"),
 				}),
+				SecretsEngine: types.StringValue("kv-v2"),
 			},
 			true,
 		},
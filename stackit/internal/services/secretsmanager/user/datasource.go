@@ -65,12 +65,12 @@ func (r *userDataSource) Configure(ctx context.Context, req datasource.Configure
 	var apiClient *secretsmanager.APIClient
 	var err error
 	if providerData.SecretsManagerCustomEndpoint != "" {
-		apiClient, err = secretsmanager.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, secretsmanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.SecretsManagerCustomEndpoint),
 		)
 	} else {
-		apiClient, err = secretsmanager.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, secretsmanager.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
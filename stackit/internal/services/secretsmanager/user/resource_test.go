@@ -25,6 +25,7 @@ func TestMapFields(t *testing.T) {
 			nil,
 			Model{
 				Id:           types.StringValue("pid,iid,uid"),
+				ImportId:     types.StringValue("pid,iid,uid"),
 				UserId:       types.StringValue("uid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -47,6 +48,7 @@ func TestMapFields(t *testing.T) {
 			nil,
 			Model{
 				Id:           types.StringValue("pid,iid,uid"),
+				ImportId:     types.StringValue("pid,iid,uid"),
 				UserId:       types.StringValue("uid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -82,6 +84,7 @@ func TestMapFields(t *testing.T) {
 			utils.Ptr("password"),
 			Model{
 				Id:           types.StringValue("pid,iid,uid"),
+				ImportId:     types.StringValue("pid,iid,uid"),
 				UserId:       types.StringValue("uid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -104,6 +107,7 @@ func TestMapFields(t *testing.T) {
 			utils.Ptr("password"),
 			Model{
 				Id:           types.StringValue("pid,iid,uid"),
+				ImportId:     types.StringValue("pid,iid,uid"),
 				UserId:       types.StringValue("uid"),
 				InstanceId:   types.StringValue("iid"),
 				ProjectId:    types.StringValue("pid"),
@@ -44,6 +44,7 @@ var (
 
 type Model struct {
 	ID               types.String                   `tfsdk:"id"`
+	ImportID         types.String                   `tfsdk:"import_id"`
 	ProjectId        types.String                   `tfsdk:"project_id"`
 	ServerId         types.String                   `tfsdk:"server_id"`
 	BackupScheduleId types.Int64                    `tfsdk:"backup_schedule_id"`
@@ -100,12 +101,12 @@ func (r *scheduleResource) Configure(ctx context.Context, req resource.Configure
 	var err error
 	if providerData.ServerBackupCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "server_backup_custom_endpoint", providerData.ServerBackupCustomEndpoint)
-		apiClient, err = serverbackup.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverbackup.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServerBackupCustomEndpoint),
 		)
 	} else {
-		apiClient, err = serverbackup.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverbackup.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -133,6 +134,13 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Description: "The schedule name.",
 				Required:    true,
@@ -245,7 +253,7 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	scheduleResp, err := r.client.CreateBackupSchedule(ctx, projectId, serverId).CreateBackupSchedulePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server backup schedule", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating server backup schedule", err, req.Plan)
 		return
 	}
 	ctx = tflog.SetField(ctx, "backup_schedule_id", *scheduleResp.Id)
@@ -330,7 +338,7 @@ func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateReques
 
 	scheduleResp, err := r.client.UpdateBackupSchedule(ctx, projectId, serverId, strconv.FormatInt(backupScheduleId, 10)).UpdateBackupSchedulePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server backup schedule", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating server backup schedule", err, req.Plan)
 		return
 	}
 
@@ -425,6 +433,7 @@ func mapFields(ctx context.Context, schedule *serverbackup.BackupSchedule, model
 	model.ID = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportID = model.ID
 	model.Name = types.StringPointerValue(schedule.Name)
 	model.Rrule = types.StringPointerValue(schedule.Rrule)
 	model.Enabled = types.BoolPointerValue(schedule.Enabled)
@@ -24,6 +24,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				ID:               types.StringValue("project_uid,server_uid,5"),
+				ImportID:         types.StringValue("project_uid,server_uid,5"),
 				ProjectId:        types.StringValue("project_uid"),
 				ServerId:         types.StringValue("server_uid"),
 				BackupScheduleId: types.Int64Value(5),
@@ -48,6 +49,7 @@ func TestMapFields(t *testing.T) {
 				ProjectId:        types.StringValue("project_uid"),
 				BackupScheduleId: types.Int64Value(5),
 				ID:               types.StringValue("project_uid,server_uid,5"),
+				ImportID:         types.StringValue("project_uid,server_uid,5"),
 				Name:             types.StringValue("backup_schedule_name_1"),
 				Rrule:            types.StringValue("DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"),
 				Enabled:          types.BoolValue(true),
@@ -71,12 +71,12 @@ func (r *scheduleDataSource) Configure(ctx context.Context, req datasource.Confi
 	var err error
 	if providerData.ServerBackupCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "server_backup_custom_endpoint", providerData.ServerBackupCustomEndpoint)
-		apiClient, err = serverbackup.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverbackup.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServerBackupCustomEndpoint),
 		)
 	} else {
-		apiClient, err = serverbackup.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverbackup.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -101,6 +101,10 @@ func (r *scheduleDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Terraform's internal resource identifier. It is structured as \"`project_id`,`server_id`,`backup_schedule_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"name": schema.StringAttribute{
 				Description: "The schedule name.",
 				Computed:    true,
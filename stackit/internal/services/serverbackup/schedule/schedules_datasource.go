@@ -70,12 +70,12 @@ func (r *schedulesDataSource) Configure(ctx context.Context, req datasource.Conf
 	var err error
 	if providerData.ServerBackupCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "server_backup_custom_endpoint", providerData.ServerBackupCustomEndpoint)
-		apiClient, err = serverbackup.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverbackup.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServerBackupCustomEndpoint),
 		)
 	} else {
-		apiClient, err = serverbackup.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverbackup.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
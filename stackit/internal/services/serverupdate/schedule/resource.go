@@ -43,6 +43,7 @@ var (
 
 type Model struct {
 	ID                types.String `tfsdk:"id"`
+	ImportID          types.String `tfsdk:"import_id"`
 	ProjectId         types.String `tfsdk:"project_id"`
 	ServerId          types.String `tfsdk:"server_id"`
 	UpdateScheduleId  types.Int64  `tfsdk:"update_schedule_id"`
@@ -92,12 +93,12 @@ func (r *scheduleResource) Configure(ctx context.Context, req resource.Configure
 	var err error
 	if providerData.ServerUpdateCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "server_update_custom_endpoint", providerData.ServerUpdateCustomEndpoint)
-		apiClient, err = serverupdate.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverupdate.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServerUpdateCustomEndpoint),
 		)
 	} else {
-		apiClient, err = serverupdate.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverupdate.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -125,6 +126,13 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Description: "The schedule name.",
 				Required:    true,
@@ -226,7 +234,7 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	scheduleResp, err := r.client.CreateUpdateSchedule(ctx, projectId, serverId).CreateUpdateSchedulePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server update schedule", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating server update schedule", err, req.Plan)
 		return
 	}
 	ctx = tflog.SetField(ctx, "update_schedule_id", *scheduleResp.Id)
@@ -311,7 +319,7 @@ func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateReques
 
 	scheduleResp, err := r.client.UpdateUpdateSchedule(ctx, projectId, serverId, strconv.FormatInt(updateScheduleId, 10)).UpdateUpdateSchedulePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server update schedule", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error updating server update schedule", err, req.Plan)
 		return
 	}
 
@@ -399,6 +407,7 @@ func mapFields(schedule *serverupdate.UpdateSchedule, model *Model) error {
 	model.ID = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportID = model.ID
 	model.Name = types.StringPointerValue(schedule.Name)
 	model.Rrule = types.StringPointerValue(schedule.Rrule)
 	model.Enabled = types.BoolPointerValue(schedule.Enabled)
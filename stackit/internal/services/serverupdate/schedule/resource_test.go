@@ -23,6 +23,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				ID:               types.StringValue("project_uid,server_uid,5"),
+				ImportID:         types.StringValue("project_uid,server_uid,5"),
 				ProjectId:        types.StringValue("project_uid"),
 				ServerId:         types.StringValue("server_uid"),
 				UpdateScheduleId: types.Int64Value(5),
@@ -43,6 +44,7 @@ func TestMapFields(t *testing.T) {
 				ProjectId:         types.StringValue("project_uid"),
 				UpdateScheduleId:  types.Int64Value(5),
 				ID:                types.StringValue("project_uid,server_uid,5"),
+				ImportID:          types.StringValue("project_uid,server_uid,5"),
 				Name:              types.StringValue("update_schedule_name_1"),
 				Rrule:             types.StringValue("DTSTART;TZID=Europe/Sofia:20200803T023000 RRULE:FREQ=DAILY;INTERVAL=1"),
 				Enabled:           types.BoolValue(true),
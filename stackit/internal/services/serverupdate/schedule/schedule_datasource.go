@@ -70,12 +70,12 @@ func (r *scheduleDataSource) Configure(ctx context.Context, req datasource.Confi
 	var err error
 	if providerData.ServerUpdateCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "server_update_custom_endpoint", providerData.ServerUpdateCustomEndpoint)
-		apiClient, err = serverupdate.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverupdate.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServerUpdateCustomEndpoint),
 		)
 	} else {
-		apiClient, err = serverupdate.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverupdate.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -100,6 +100,10 @@ func (r *scheduleDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: "Terraform's internal resource identifier. It is structured as \"`project_id`,`server_id`,`update_schedule_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"name": schema.StringAttribute{
 				Description: "The schedule name.",
 				Computed:    true,
@@ -70,12 +70,12 @@ func (r *schedulesDataSource) Configure(ctx context.Context, req datasource.Conf
 	var err error
 	if providerData.ServerUpdateCustomEndpoint != "" {
 		ctx = tflog.SetField(ctx, "server_update_custom_endpoint", providerData.ServerUpdateCustomEndpoint)
-		apiClient, err = serverupdate.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverupdate.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServerUpdateCustomEndpoint),
 		)
 	} else {
-		apiClient, err = serverupdate.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, serverupdate.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -0,0 +1,200 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &clustersDataSource{}
+)
+
+// NewClustersDataSource is a helper function to simplify the provider implementation.
+func NewClustersDataSource() datasource.DataSource {
+	return &clustersDataSource{}
+}
+
+// clustersDataSource is the data source implementation.
+type clustersDataSource struct {
+	client *ske.APIClient
+}
+
+// clustersDataSourceModel maps the data source schema data.
+type clustersDataSourceModel struct {
+	Id        types.String                  `tfsdk:"id"` // needed by TF
+	ProjectId types.String                  `tfsdk:"project_id"`
+	Items     []clustersDataSourceItemModel `tfsdk:"items"`
+}
+
+// clustersDataSourceItemModel maps cluster schema data.
+type clustersDataSourceItemModel struct {
+	Name              types.String `tfsdk:"name"`
+	KubernetesVersion types.String `tfsdk:"kubernetes_version_used"`
+	Status            types.String `tfsdk:"status"`
+	Hibernated        types.Bool   `tfsdk:"hibernated"`
+}
+
+// Metadata returns the data source type name.
+func (r *clustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_clusters"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *clustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(core.ProviderData)
+	if !ok {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
+		return
+	}
+
+	var apiClient *ske.APIClient
+	var err error
+	if providerData.SKECustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.SKECustomEndpoint),
+		)
+	} else {
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithRegion(providerData.Region),
+		)
+	}
+
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Configuring client: %v. This is an error related to the provider configuration, not to the data source configuration", err))
+		return
+	}
+
+	r.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *clustersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "SKE clusters data source schema. Lists all SKE clusters of a project. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the clusters are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "The SKE clusters of the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The cluster name.",
+							Computed:    true,
+						},
+						"kubernetes_version_used": schema.StringAttribute{
+							Description: "Full Kubernetes version used.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Aggregated status of the cluster, e.g. `STATE_HEALTHY`.",
+							Computed:    true,
+						},
+						"hibernated": schema.BoolAttribute{
+							Description: "Whether the cluster is currently hibernated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model clustersDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	clustersResp, err := r.client.ListClusters(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading clusters", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapClustersDataSourceFields(clustersResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading clusters", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE clusters read")
+}
+
+func mapClustersDataSourceFields(clustersResp *ske.ListClustersResponse, model *clustersDataSourceModel) error {
+	if clustersResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = model.ProjectId
+	model.Items = []clustersDataSourceItemModel{}
+	if clustersResp.Items == nil {
+		return nil
+	}
+	for i := range *clustersResp.Items {
+		cluster := &(*clustersResp.Items)[i]
+		if cluster.Name == nil {
+			return fmt.Errorf("cluster name not present")
+		}
+		item := clustersDataSourceItemModel{
+			Name:              types.StringPointerValue(cluster.Name),
+			KubernetesVersion: types.StringNull(),
+			Status:            types.StringNull(),
+			Hibernated:        types.BoolNull(),
+		}
+		if cluster.Kubernetes != nil {
+			item.KubernetesVersion = types.StringPointerValue(cluster.Kubernetes.Version)
+		}
+		if cluster.Status != nil {
+			if cluster.Status.Aggregated != nil {
+				item.Status = types.StringValue(string(*cluster.Status.Aggregated))
+			}
+			item.Hibernated = types.BoolPointerValue(cluster.Status.Hibernated)
+		}
+		model.Items = append(model.Items, item)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package ske
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapClustersDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.ListClustersResponse
+		expected    clustersDataSourceModel
+		isValid     bool
+	}{
+		{
+			"no_clusters",
+			&ske.ListClustersResponse{},
+			clustersDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items:     []clustersDataSourceItemModel{},
+			},
+			true,
+		},
+		{
+			"simple_values",
+			&ske.ListClustersResponse{
+				Items: &[]ske.Cluster{
+					{
+						Name: utils.Ptr("name"),
+						Kubernetes: &ske.Kubernetes{
+							Version: utils.Ptr("1.31.1"),
+						},
+						Status: &ske.ClusterStatus{
+							Aggregated: utils.Ptr(ske.CLUSTERSTATUSSTATE_HEALTHY),
+							Hibernated: utils.Ptr(false),
+						},
+					},
+					{
+						Name: utils.Ptr("name-2"),
+					},
+				},
+			},
+			clustersDataSourceModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Items: []clustersDataSourceItemModel{
+					{
+						Name:              types.StringValue("name"),
+						KubernetesVersion: types.StringValue("1.31.1"),
+						Status:            types.StringValue("STATE_HEALTHY"),
+						Hibernated:        types.BoolValue(false),
+					},
+					{
+						Name:              types.StringValue("name-2"),
+						KubernetesVersion: types.StringNull(),
+						Status:            types.StringNull(),
+						Hibernated:        types.BoolNull(),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"no_cluster_name",
+			&ske.ListClustersResponse{
+				Items: &[]ske.Cluster{
+					{},
+				},
+			},
+			clustersDataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			clustersDataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &clustersDataSourceModel{
+				ProjectId: tt.expected.ProjectId,
+			}
+			err := mapClustersDataSourceFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -53,12 +53,12 @@ func (r *clusterDataSource) Configure(ctx context.Context, req datasource.Config
 	var apiClient *ske.APIClient
 	var err error
 	if providerData.SKECustomEndpoint != "" {
-		apiClient, err = ske.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
-		apiClient, err = ske.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -80,6 +80,10 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`,`name`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the cluster is associated.",
 				Required:    true,
@@ -115,6 +119,18 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"status": schema.StringAttribute{
+				Description: "Aggregated status of the cluster, e.g. `STATE_HEALTHY`.",
+				Computed:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "URL of the Kubernetes API server of the cluster.",
+				Computed:    true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate of the Kubernetes API server of the cluster.",
+				Computed:    true,
+			},
 			"node_pools": schema.ListNestedAttribute{
 				Description: "One or more `node_pool` block as defined below.",
 				Computed:    true,
@@ -351,6 +367,7 @@ func (r *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	loadClusterEndpoint(ctx, r.client, &state, &resp.Diagnostics)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
@@ -2,6 +2,7 @@ package ske
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -9,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -25,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -58,15 +62,18 @@ const (
 var (
 	_ resource.Resource                = &clusterResource{}
 	_ resource.ResourceWithConfigure   = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
 	_ resource.ResourceWithImportState = &clusterResource{}
 )
 
 type skeClient interface {
 	GetClusterExecute(ctx context.Context, projectId, clusterName string) (*ske.Cluster, error)
+	GetLoginKubeconfigExecute(ctx context.Context, projectId, clusterName string) (*ske.LoginKubeconfig, error)
 }
 
 type Model struct {
 	Id                        types.String `tfsdk:"id"` // needed by TF
+	ImportId                  types.String `tfsdk:"import_id"`
 	ProjectId                 types.String `tfsdk:"project_id"`
 	Name                      types.String `tfsdk:"name"`
 	KubernetesVersionMin      types.String `tfsdk:"kubernetes_version_min"`
@@ -79,6 +86,15 @@ type Model struct {
 	Hibernations              types.List   `tfsdk:"hibernations"`
 	Extensions                types.Object `tfsdk:"extensions"`
 	EgressAddressRanges       types.List   `tfsdk:"egress_address_ranges"`
+	Status                    types.String `tfsdk:"status"`
+	Endpoint                  types.String `tfsdk:"endpoint"`
+	ClusterCACertificate      types.String `tfsdk:"cluster_ca_certificate"`
+}
+
+// ResourceModel extends the Model shared with the data source by the resource-only timeouts block
+type ResourceModel struct {
+	Model
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 // Struct corresponding to Model.NodePools[i]
@@ -236,6 +252,7 @@ func NewClusterResource() resource.Resource {
 type clusterResource struct {
 	skeClient        *ske.APIClient
 	enablementClient *serviceenablement.APIClient
+	providerData     core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -260,12 +277,12 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	var enablementClient *serviceenablement.APIClient
 	var err error
 	if providerData.SKECustomEndpoint != "" {
-		skeClient, err = ske.NewAPIClient(
+		skeClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
-		skeClient, err = ske.NewAPIClient(
+		skeClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -277,12 +294,12 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 
 	if providerData.ServiceEnablementCustomEndpoint != "" {
-		enablementClient, err = serviceenablement.NewAPIClient(
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServiceEnablementCustomEndpoint),
 		)
 	} else {
-		enablementClient, err = serviceenablement.NewAPIClient(
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -295,9 +312,16 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	r.skeClient = skeClient
 	r.enablementClient = enablementClient
+	r.providerData = providerData
 	tflog.Info(ctx, "SKE cluster clients configured")
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the project when planning the creation of the resource, if enabled.
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
@@ -306,7 +330,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"However, the SKE API correctly identifies node pools by name and applies the intended changes. Please review your changes carefully to ensure the correct configuration will be applied.",
 		"max_surge":           "Maximum number of additional VMs that are created during an update.",
 		"max_unavailable":     "Maximum number of VMs that that can be unavailable during an update.",
-		"nodepool_validators": "If set (larger than 0), then it must be at least the amount of zones configured for the nodepool. The `max_surge` and `max_unavailable` fields cannot both be unset at the same time.",
+		"nodepool_validators": "If set (larger than 0), then it must be at least the amount of zones configured for the nodepool. The `max_surge` and `max_unavailable` fields cannot both be unset or set to 0 at the same time.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -321,6 +345,13 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT project ID to which the cluster is associated.",
 				Required:    true,
@@ -381,6 +412,24 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"status": schema.StringAttribute{
+				Description: "Aggregated status of the cluster, e.g. `STATE_HEALTHY`.",
+				Computed:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "URL of the Kubernetes API server of the cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate of the Kubernetes API server of the cluster. Together with `endpoint`, it can be used to configure the kubernetes provider with exec-based authentication.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"node_pools": schema.ListNestedAttribute{
 				Description: "One or more `node_pool` block as defined below.",
 				Required:    true,
@@ -420,14 +469,20 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 						},
 						"max_unavailable": schema.Int64Attribute{
-							Description: fmt.Sprintf("%s %s", descriptions["max_surge"], descriptions["nodepool_validators"]),
+							Description: fmt.Sprintf("%s %s", descriptions["max_unavailable"], descriptions["nodepool_validators"]),
 							Optional:    true,
 							Computed:    true,
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 						},
 						"os_name": schema.StringAttribute{
 							Description: "The name of the OS image. Defaults to `flatcar`.",
@@ -436,7 +491,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							Default:     stringdefault.StaticString(DefaultOSName),
 						},
 						"os_version_min": schema.StringAttribute{
-							Description: "The minimum OS image version. This field will be used to set the minimum OS image version on creation/update of the cluster. If unset, the latest supported OS image version will be used. " + SKEUpdateDoc + " To get the current OS image version being used for the node pool, use the read-only `os_version_used` field.",
+							Description: "The minimum OS image version. This field will be used to set the minimum OS image version on creation/update of the cluster. If unset, the latest supported OS image version will be used. " + SKEUpdateDoc + " To pin the node pool to a specific OS image version, set the full version (e.g. `3815.2.2`) and disable `maintenance.enable_machine_image_version_updates`. Mandatory updates are still applied, and versions lower than the one currently used are ignored to avoid downgrades. To get the current OS image version being used for the node pool, use the read-only `os_version_used` field.",
 							Optional:    true,
 							Validators: []validator.String{
 								validate.VersionNumber(),
@@ -608,6 +663,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							"argus_instance_id": schema.StringAttribute{
 								Description: "Argus instance ID to choose which Argus instance is used. Required when enabled is set to `true`.",
 								Optional:    true,
+								Validators: []validator.String{
+									validate.UUID(),
+								},
 							},
 						},
 					},
@@ -623,6 +681,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 								Description: "Specify a list of CIDRs to whitelist.",
 								Required:    true,
 								ElementType: types.StringType,
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(validate.CIDR()),
+								},
 							},
 						},
 					},
@@ -644,6 +705,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": utils.TimeoutsBlock(),
+		},
 	}
 }
 
@@ -655,7 +719,123 @@ func (r *clusterResource) ConfigValidators(_ context.Context) []resource.ConfigV
 			path.MatchRoot("kubernetes_version"),
 			path.MatchRoot("kubernetes_version_min"),
 		),
+		validate.NewResourceValidator(
+			"node pool sizes and rolling update settings must be consistent with the configured availability zones",
+			func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+				var model ResourceModel
+				resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(checkNodePools(ctx, &model.Model)...)
+			},
+		),
+		validate.NewResourceValidator(
+			"an enabled argus extension must reference an argus instance",
+			func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+				var model ResourceModel
+				resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(checkExtensions(ctx, &model.Model)...)
+			},
+		),
+	}
+}
+
+// checkExtensions validates that the argus extension references an argus instance when it is enabled.
+// Values that are not yet known are skipped.
+func checkExtensions(ctx context.Context, model *Model) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if utils.IsUndefined(model.Extensions) {
+		return diags
+	}
+
+	ex := extensions{}
+	diags.Append(model.Extensions.As(ctx, &ex, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || utils.IsUndefined(ex.Argus) {
+		return diags
+	}
+
+	argusModel := argus{}
+	diags.Append(ex.Argus.As(ctx, &argusModel, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	if argusModel.Enabled.IsUnknown() || !argusModel.Enabled.ValueBool() || !argusModel.ArgusInstanceId.IsNull() {
+		return diags
+	}
+	diags.AddAttributeError(
+		path.Root("extensions").AtName("argus").AtName("argus_instance_id"),
+		"Invalid extensions configuration",
+		"The argus_instance_id must be set when the argus extension is enabled.",
+	)
+	return diags
+}
+
+// checkNodePools validates the relations between the attributes of each node pool:
+// the minimum can't exceed the maximum, max_surge and max_unavailable can't both be 0 and,
+// if set, they must cover all availability zones.
+// Values that are not yet known are skipped.
+func checkNodePools(ctx context.Context, model *Model) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if utils.IsUndefined(model.NodePools) {
+		return diags
+	}
+
+	nodePools := []nodePool{}
+	diags.Append(model.NodePools.ElementsAs(ctx, &nodePools, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i := range nodePools {
+		np := &nodePools[i]
+		nodePoolPath := path.Root("node_pools").AtListIndex(i)
+
+		if !utils.IsUndefined(np.Minimum) && !utils.IsUndefined(np.Maximum) && np.Minimum.ValueInt64() > np.Maximum.ValueInt64() {
+			diags.AddAttributeError(
+				nodePoolPath.AtName("minimum"),
+				"Invalid node pool configuration",
+				fmt.Sprintf("The minimum (%d) of node pool %q can't be larger than its maximum (%d).", np.Minimum.ValueInt64(), np.Name.ValueString(), np.Maximum.ValueInt64()),
+			)
+		}
+
+		if !utils.IsUndefined(np.MaxSurge) && !utils.IsUndefined(np.MaxUnavailable) && np.MaxSurge.ValueInt64() == 0 && np.MaxUnavailable.ValueInt64() == 0 {
+			diags.AddAttributeError(
+				nodePoolPath.AtName("max_surge"),
+				"Invalid node pool configuration",
+				fmt.Sprintf("The max_surge and max_unavailable of node pool %q can't both be 0, otherwise its nodes can't be updated.", np.Name.ValueString()),
+			)
+		}
+
+		if utils.IsUndefined(np.AvailabilityZones) {
+			continue
+		}
+		zones := len(np.AvailabilityZones.Elements())
+		rollingUpdateSettings := []struct {
+			attribute string
+			value     types.Int64
+		}{
+			{"max_surge", np.MaxSurge},
+			{"max_unavailable", np.MaxUnavailable},
+		}
+		for _, setting := range rollingUpdateSettings {
+			attribute, value := setting.attribute, setting.value
+			if utils.IsUndefined(value) || value.ValueInt64() <= 0 {
+				continue
+			}
+			if value.ValueInt64() < int64(zones) {
+				diags.AddAttributeError(
+					nodePoolPath.AtName(attribute),
+					"Invalid node pool configuration",
+					fmt.Sprintf("The %s (%d) of node pool %q must be at least the amount of availability zones configured for the node pool (%d).", attribute, value.ValueInt64(), np.Name.ValueString(), zones),
+				)
+			}
+		}
 	}
+	return diags
 }
 
 // needs to be executed inside the Create and Update methods
@@ -688,7 +868,7 @@ func checkAllowPrivilegedContainers(allowPrivilegeContainers types.Bool, kuberne
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -711,14 +891,20 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "name", clusterName)
 
+	createTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutCreate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	// If SKE functionality is not enabled, enable it
-	err := r.enablementClient.EnableService(ctx, projectId, utils.SKEServiceId).Execute()
+	err = r.enablementClient.EnableService(ctx, projectId, utils.SKEServiceId).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Calling API to enable SKE: %v", err))
 		return
 	}
 
-	_, err = enablementWait.EnableServiceWaitHandler(ctx, r.enablementClient, projectId, utils.SKEServiceId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, enablementWait.EnableServiceWaitHandler(ctx, r.enablementClient, projectId, utils.SKEServiceId), "SKE service enablement")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Wait for SKE enablement: %v", err))
 		return
@@ -730,7 +916,7 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, nil, nil)
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, req.Plan, &model.Model, availableKubernetesVersions, availableMachines, nil, nil, createTimeout)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -817,7 +1003,7 @@ func getCurrentVersions(ctx context.Context, c skeClient, m *Model) (kubernetesV
 	return kubernetesVersion, nodePoolMachineImages
 }
 
-func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, model *Model, availableKubernetesVersions []ske.KubernetesVersion, availableMachineVersions []ske.MachineImage, currentKubernetesVersion *string, currentMachineImages map[string]*ske.Image) {
+func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, plan tfsdk.Plan, model *Model, availableKubernetesVersions []ske.KubernetesVersion, availableMachineVersions []ske.MachineImage, currentKubernetesVersion *string, currentMachineImages map[string]*ske.Image, timeout time.Duration) {
 	// cluster vars
 	projectId := model.ProjectId.ValueString()
 	name := model.Name.ValueString()
@@ -868,11 +1054,11 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 	}
 	_, err = r.skeClient.CreateOrUpdateCluster(ctx, projectId, name).CreateOrUpdateClusterPayload(payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, diags, "Error creating/updating cluster", err, plan)
 		return
 	}
 
-	waitResp, err := skeWait.CreateOrUpdateClusterWaitHandler(ctx, r.skeClient, projectId, name).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, utils.WithTimeout(skeWait.CreateOrUpdateClusterWaitHandler(ctx, r.skeClient, projectId, name), timeout), "SKE cluster creation or update")
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Cluster creation waiting: %v", err))
 		return
@@ -886,6 +1072,7 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	loadClusterEndpoint(ctx, r.skeClient, model, diags)
 }
 
 func toNodepoolsPayload(ctx context.Context, m *Model, availableMachineVersions []ske.MachineImage, currentMachineImages map[string]*ske.Image) ([]ske.Nodepool, []string, error) {
@@ -1348,6 +1535,7 @@ func mapFields(ctx context.Context, cl *ske.Cluster, m *Model) error {
 	m.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	m.ImportId = m.Id
 
 	if cl.Kubernetes != nil {
 		m.KubernetesVersionUsed = types.StringPointerValue(cl.Kubernetes.Version)
@@ -1355,7 +1543,11 @@ func mapFields(ctx context.Context, cl *ske.Cluster, m *Model) error {
 	}
 
 	m.EgressAddressRanges = types.ListNull(types.StringType)
+	m.Status = types.StringNull()
 	if cl.Status != nil {
+		if cl.Status.Aggregated != nil {
+			m.Status = types.StringValue(string(*cl.Status.Aggregated))
+		}
 		var diags diag.Diagnostics
 		m.EgressAddressRanges, diags = types.ListValueFrom(ctx, types.StringType, cl.Status.EgressAddressRanges)
 		if diags.HasError() {
@@ -2026,7 +2218,7 @@ func getLatestSupportedKubernetesVersion(versions []ske.KubernetesVersion) (*str
 }
 
 func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var state Model
+	var state ResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -2048,11 +2240,13 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	err = mapFields(ctx, clResp, &state)
+	err = mapFields(ctx, clResp, &state.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	checkClusterStatus(ctx, state.Status.ValueString(), &resp.Diagnostics)
+	loadClusterEndpoint(ctx, r.skeClient, &state.Model, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -2062,8 +2256,130 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Info(ctx, "SKE cluster read")
 }
 
+// loadClusterEndpoint sets the API server endpoint and the CA certificate of the cluster in the model.
+// They are read from the login kubeconfig of the cluster, which doesn't contain any credentials.
+// Since they don't change during the lifetime of the cluster, they are only read if they are not known yet,
+// i.e. when the cluster is created or imported, or by the data source.
+// If they can't be read, a warning is added and the values are set to null.
+func loadClusterEndpoint(ctx context.Context, c skeClient, m *Model, diags *diag.Diagnostics) {
+	if !utils.IsUndefined(m.Endpoint) && !utils.IsUndefined(m.ClusterCACertificate) {
+		return
+	}
+	if m.Endpoint.IsUnknown() {
+		m.Endpoint = types.StringNull()
+	}
+	if m.ClusterCACertificate.IsUnknown() {
+		m.ClusterCACertificate = types.StringNull()
+	}
+
+	loginKubeconfig, err := c.GetLoginKubeconfigExecute(ctx, m.ProjectId.ValueString(), m.Name.ValueString())
+	if err != nil {
+		core.LogAndAddWarning(ctx, diags, "Error reading cluster endpoint", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	if loginKubeconfig == nil || loginKubeconfig.Kubeconfig == nil {
+		core.LogAndAddWarning(ctx, diags, "Error reading cluster endpoint", "Empty login kubeconfig returned by the API")
+		return
+	}
+	endpoint, caCertificate, err := parseKubeconfigCluster(*loginKubeconfig.Kubeconfig)
+	if err != nil {
+		core.LogAndAddWarning(ctx, diags, "Error reading cluster endpoint", fmt.Sprintf("Processing login kubeconfig: %v", err))
+		return
+	}
+	m.Endpoint = types.StringValue(endpoint)
+	m.ClusterCACertificate = types.StringValue(caCertificate)
+}
+
+// parseKubeconfigCluster returns the server and the decoded certificate authority data of the first cluster of the kubeconfig.
+// The provider has no YAML dependency (client-go is not part of the module), so the kubeconfig is read in the block style
+// written by the SKE API: only the direct keys of the "cluster" mapping of the first entry of the top-level "clusters"
+// list are considered, so that values elsewhere (e.g. in contexts, users or further clusters) are never picked up.
+func parseKubeconfigCluster(kubeconfig string) (server, caCertificate string, err error) {
+	var caData string
+	section := ""
+	entries := 0
+	clusterIndent := -1
+	keyIndent := -1
+	for _, line := range strings.Split(kubeconfig, "\n") {
+		content := strings.TrimSpace(line)
+		if content == "" || strings.HasPrefix(content, "#") {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if indent == 0 && !strings.HasPrefix(content, "- ") {
+			section, _, _ = strings.Cut(content, ":")
+			continue
+		}
+		if section != "clusters" {
+			continue
+		}
+		if strings.HasPrefix(content, "- ") {
+			entries++
+			content = strings.TrimPrefix(content, "- ")
+			indent += 2
+		}
+		if entries != 1 {
+			continue
+		}
+		key, value, found := strings.Cut(content, ":")
+		if !found {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if clusterIndent >= 0 && indent <= clusterIndent {
+			clusterIndent = -1
+		}
+		if clusterIndent < 0 {
+			if key == "cluster" && value == "" {
+				clusterIndent = indent
+				keyIndent = -1
+			}
+			continue
+		}
+		if keyIndent < 0 {
+			keyIndent = indent
+		}
+		if indent != keyIndent {
+			continue
+		}
+		switch key {
+		case "server":
+			server = value
+		case "certificate-authority-data":
+			caData = value
+		}
+	}
+	if server == "" {
+		return "", "", fmt.Errorf("no server found in kubeconfig")
+	}
+	if caData == "" {
+		return "", "", fmt.Errorf("no certificate authority data found in kubeconfig")
+	}
+	caCertificateBytes, err := base64.StdEncoding.DecodeString(caData)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding certificate authority data: %w", err)
+	}
+	return server, string(caCertificateBytes), nil
+}
+
+// checkClusterStatus adds a warning if the cluster is in a state which needs user attention,
+// so that the plan is not blocked but the user is informed about how to proceed.
+func checkClusterStatus(ctx context.Context, status string, diags *diag.Diagnostics) {
+	switch status {
+	case string(ske.CLUSTERSTATUSSTATE_DELETING):
+		core.LogAndAddWarning(ctx, diags, "Cluster is being deleted",
+			"The cluster is currently being deleted. Wait until the deletion has finished before running the next apply, the cluster will then be removed from the state and recreated if it is still part of the configuration.")
+	case skeWait.StateFailed:
+		core.LogAndAddWarning(ctx, diags, "Cluster is in a failed state",
+			"The cluster creation or update has failed. Check the cluster status in the STACKIT portal and, if it does not recover, recreate it with `terraform apply -replace` (or `terraform taint`).")
+	case string(ske.CLUSTERSTATUSSTATE_UNHEALTHY):
+		core.LogAndAddWarning(ctx, diags, "Cluster is unhealthy",
+			"The cluster is reported as unhealthy. Changes applied now may not be reconciled until the cluster has recovered.")
+	}
+}
+
 func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -2087,15 +2403,21 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "name", clName)
 
+	updateTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutUpdate)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	availableKubernetesVersions, availableMachines, err := r.loadAvailableVersions(ctx)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster", fmt.Sprintf("Loading available Kubernetes and machine image versions: %v", err))
 		return
 	}
 
-	currentKubernetesVersion, currentMachineImages := getCurrentVersions(ctx, r.skeClient, &model)
+	currentKubernetesVersion, currentMachineImages := getCurrentVersions(ctx, r.skeClient, &model.Model)
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages)
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, req.Plan, &model.Model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages, updateTimeout)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2109,7 +2431,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -2119,13 +2441,19 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "name", name)
 
+	deleteTimeout, err := utils.Timeout(model.Timeouts, utils.TimeoutDelete)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Reading timeouts: %v", err))
+		return
+	}
+
 	c := r.skeClient
-	_, err := c.DeleteCluster(ctx, projectId, name).Execute()
+	_, err = c.DeleteCluster(ctx, projectId, name).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = skeWait.DeleteClusterWaitHandler(ctx, r.skeClient, projectId, name).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, utils.WithTimeout(skeWait.DeleteClusterWaitHandler(ctx, r.skeClient, projectId, name), deleteTimeout), "SKE cluster deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Cluster deletion waiting: %v", err))
 		return
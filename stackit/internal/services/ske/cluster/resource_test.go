@@ -19,8 +19,10 @@ import (
 )
 
 type skeClientMocked struct {
-	returnError    bool
-	getClusterResp *ske.Cluster
+	returnError             bool
+	getClusterResp          *ske.Cluster
+	getLoginKubeconfigResp  *ske.LoginKubeconfig
+	getLoginKubeconfigFails bool
 }
 
 func (c *skeClientMocked) GetClusterExecute(_ context.Context, _, _ string) (*ske.Cluster, error) {
@@ -31,6 +33,14 @@ func (c *skeClientMocked) GetClusterExecute(_ context.Context, _, _ string) (*sk
 	return c.getClusterResp, nil
 }
 
+func (c *skeClientMocked) GetLoginKubeconfigExecute(_ context.Context, _, _ string) (*ske.LoginKubeconfig, error) {
+	if c.getLoginKubeconfigFails {
+		return nil, fmt.Errorf("get login kubeconfig failed")
+	}
+
+	return c.getLoginKubeconfigResp, nil
+}
+
 func TestMapFields(t *testing.T) {
 	cs := ske.ClusterStatusState("OK")
 	tests := []struct {
@@ -50,6 +60,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
@@ -151,6 +162,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
@@ -163,6 +175,7 @@ func TestMapFields(t *testing.T) {
 						types.StringValue("1.1.1.1/32"),
 					},
 				),
+				Status: types.StringValue("OK"),
 				NodePools: types.ListValueMust(
 					types.ObjectType{AttrTypes: nodePoolTypes},
 					[]attr.Value{
@@ -266,6 +279,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
@@ -302,6 +316,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
@@ -350,6 +365,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
@@ -409,6 +425,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
@@ -446,6 +463,7 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
@@ -575,12 +593,14 @@ func TestMapFields(t *testing.T) {
 			},
 			Model{
 				Id:                        types.StringValue("pid,name"),
+				ImportId:                  types.StringValue("pid,name"),
 				ProjectId:                 types.StringValue("pid"),
 				Name:                      types.StringValue("name"),
 				KubernetesVersion:         types.StringNull(),
 				KubernetesVersionUsed:     types.StringValue("1.2.3"),
 				AllowPrivilegedContainers: types.BoolValue(true),
 				EgressAddressRanges:       types.ListNull(types.StringType),
+				Status:                    types.StringValue("OK"),
 				NodePools: types.ListValueMust(
 					types.ObjectType{AttrTypes: nodePoolTypes},
 					[]attr.Value{
@@ -2552,3 +2572,377 @@ func TestSortK8sVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckNodePools(t *testing.T) {
+	newNodePool := func(minimum, maximum, maxSurge, maxUnavailable types.Int64, zones ...string) attr.Value {
+		zoneValues := []attr.Value{}
+		for _, zone := range zones {
+			zoneValues = append(zoneValues, types.StringValue(zone))
+		}
+		return types.ObjectValueMust(nodePoolTypes, map[string]attr.Value{
+			"name":                    types.StringValue("np"),
+			"machine_type":            types.StringValue("b1.2"),
+			"os_name":                 types.StringNull(),
+			"os_version_min":          types.StringNull(),
+			"os_version":              types.StringNull(),
+			"os_version_used":         types.StringNull(),
+			"minimum":                 minimum,
+			"maximum":                 maximum,
+			"max_surge":               maxSurge,
+			"max_unavailable":         maxUnavailable,
+			"volume_type":             types.StringNull(),
+			"volume_size":             types.Int64Null(),
+			"labels":                  types.MapNull(types.StringType),
+			"taints":                  types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+			"cri":                     types.StringNull(),
+			"availability_zones":      types.ListValueMust(types.StringType, zoneValues),
+			"allow_system_components": types.BoolNull(),
+		})
+	}
+
+	tests := []struct {
+		description string
+		nodePools   types.List
+		isValid     bool
+	}{
+		{
+			"null node pools",
+			types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes}),
+			true,
+		},
+		{
+			"valid node pool",
+			types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{
+				newNodePool(types.Int64Value(1), types.Int64Value(3), types.Int64Value(2), types.Int64Value(0), "eu01-1", "eu01-2"),
+			}),
+			true,
+		},
+		{
+			"unset rolling update settings",
+			types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{
+				newNodePool(types.Int64Value(1), types.Int64Value(3), types.Int64Null(), types.Int64Unknown(), "eu01-1", "eu01-2", "eu01-3"),
+			}),
+			true,
+		},
+		{
+			"minimum larger than maximum",
+			types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{
+				newNodePool(types.Int64Value(4), types.Int64Value(3), types.Int64Value(1), types.Int64Value(0), "eu01-1"),
+			}),
+			false,
+		},
+		{
+			"max_surge lower than zones",
+			types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{
+				newNodePool(types.Int64Value(1), types.Int64Value(3), types.Int64Value(1), types.Int64Value(0), "eu01-1", "eu01-2"),
+			}),
+			false,
+		},
+		{
+			"max_surge and max_unavailable both 0",
+			types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{
+				newNodePool(types.Int64Value(1), types.Int64Value(1), types.Int64Value(0), types.Int64Value(0), "eu01-1"),
+			}),
+			false,
+		},
+		{
+			"max_surge 0 and max_unavailable unknown",
+			types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{
+				newNodePool(types.Int64Value(1), types.Int64Value(1), types.Int64Value(0), types.Int64Unknown(), "eu01-1"),
+			}),
+			true,
+		},
+		{
+			"max_unavailable lower than zones",
+			types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{
+				newNodePool(types.Int64Value(1), types.Int64Value(3), types.Int64Value(0), types.Int64Value(1), "eu01-1", "eu01-2"),
+			}),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{NodePools: tt.nodePools}
+			diags := checkNodePools(context.Background(), model)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestCheckExtensions(t *testing.T) {
+	newExtensions := func(argusValue types.Object) types.Object {
+		return types.ObjectValueMust(extensionsTypes, map[string]attr.Value{
+			"argus": argusValue,
+			"acl":   types.ObjectNull(aclTypes),
+			"dns":   types.ObjectNull(dnsTypes),
+		})
+	}
+	newArgus := func(enabled types.Bool, argusInstanceId types.String) types.Object {
+		return types.ObjectValueMust(argusTypes, map[string]attr.Value{
+			"enabled":           enabled,
+			"argus_instance_id": argusInstanceId,
+		})
+	}
+
+	tests := []struct {
+		description string
+		extensions  types.Object
+		isValid     bool
+	}{
+		{
+			"null extensions",
+			types.ObjectNull(extensionsTypes),
+			true,
+		},
+		{
+			"null argus",
+			newExtensions(types.ObjectNull(argusTypes)),
+			true,
+		},
+		{
+			"enabled argus with instance",
+			newExtensions(newArgus(types.BoolValue(true), types.StringValue("aid"))),
+			true,
+		},
+		{
+			"enabled argus with unknown instance",
+			newExtensions(newArgus(types.BoolValue(true), types.StringUnknown())),
+			true,
+		},
+		{
+			"disabled argus without instance",
+			newExtensions(newArgus(types.BoolValue(false), types.StringNull())),
+			true,
+		},
+		{
+			"unknown enabled without instance",
+			newExtensions(newArgus(types.BoolUnknown(), types.StringNull())),
+			true,
+		},
+		{
+			"enabled argus without instance",
+			newExtensions(newArgus(types.BoolValue(true), types.StringNull())),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{Extensions: tt.extensions}
+			diags := checkExtensions(context.Background(), model)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+const testLoginKubeconfig = `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2EtY2VydGlmaWNhdGU=
+    server: https://api.cluster.example.com
+  name: cluster
+contexts:
+- context:
+    cluster: cluster
+    user: cluster
+  name: cluster
+current-context: cluster
+kind: Config
+users:
+- name: cluster
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: stackit
+`
+
+func TestParseKubeconfigCluster(t *testing.T) {
+	tests := []struct {
+		description           string
+		kubeconfig            string
+		expectedServer        string
+		expectedCACertificate string
+		isValid               bool
+	}{
+		{
+			"ok",
+			testLoginKubeconfig,
+			"https://api.cluster.example.com",
+			"ca-certificate",
+			true,
+		},
+		{
+			"quoted_values",
+			"clusters:\n- cluster:\n    server: \"https://api.cluster.example.com\"\n    certificate-authority-data: 'Y2EtY2VydGlmaWNhdGU='\n",
+			"https://api.cluster.example.com",
+			"ca-certificate",
+			true,
+		},
+		{
+			"first_cluster",
+			"clusters:\n- cluster:\n    certificate-authority-data: Y2EtY2VydGlmaWNhdGU=\n    server: https://api.cluster.example.com\n  name: cluster\n- cluster:\n    certificate-authority-data: b3RoZXI=\n    server: https://other.example.com\n  name: other\n",
+			"https://api.cluster.example.com",
+			"ca-certificate",
+			true,
+		},
+		{
+			"server_outside_clusters",
+			"users:\n- name: cluster\n  user:\n    server: https://other.example.com\nclusters:\n- cluster:\n    certificate-authority-data: Y2EtY2VydGlmaWNhdGU=\n",
+			"",
+			"",
+			false,
+		},
+		{
+			"no_server",
+			"clusters:\n- cluster:\n    certificate-authority-data: Y2EtY2VydGlmaWNhdGU=\n",
+			"",
+			"",
+			false,
+		},
+		{
+			"no_ca_data",
+			"clusters:\n- cluster:\n    server: https://api.cluster.example.com\n",
+			"",
+			"",
+			false,
+		},
+		{
+			"invalid_ca_data",
+			"clusters:\n- cluster:\n    server: https://api.cluster.example.com\n    certificate-authority-data: not-base64!\n",
+			"",
+			"",
+			false,
+		},
+		{
+			"empty",
+			"",
+			"",
+			"",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			server, caCertificate, err := parseKubeconfigCluster(tt.kubeconfig)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if server != tt.expectedServer {
+					t.Fatalf("Server does not match: expected %q, got %q", tt.expectedServer, server)
+				}
+				if caCertificate != tt.expectedCACertificate {
+					t.Fatalf("CA certificate does not match: expected %q, got %q", tt.expectedCACertificate, caCertificate)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadClusterEndpoint(t *testing.T) {
+	tests := []struct {
+		description             string
+		model                   Model
+		getLoginKubeconfigResp  *ske.LoginKubeconfig
+		getLoginKubeconfigFails bool
+		expectedEndpoint        types.String
+		expectedCACertificate   types.String
+		expectWarning           bool
+	}{
+		{
+			"ok",
+			Model{
+				Endpoint:             types.StringUnknown(),
+				ClusterCACertificate: types.StringUnknown(),
+			},
+			&ske.LoginKubeconfig{Kubeconfig: utils.Ptr(testLoginKubeconfig)},
+			false,
+			types.StringValue("https://api.cluster.example.com"),
+			types.StringValue("ca-certificate"),
+			false,
+		},
+		{
+			"known_values_not_read",
+			Model{
+				Endpoint:             types.StringValue("https://api.cluster.example.com"),
+				ClusterCACertificate: types.StringValue("ca-certificate"),
+			},
+			nil,
+			true,
+			types.StringValue("https://api.cluster.example.com"),
+			types.StringValue("ca-certificate"),
+			false,
+		},
+		{
+			"null_values_read",
+			Model{
+				Endpoint:             types.StringNull(),
+				ClusterCACertificate: types.StringNull(),
+			},
+			&ske.LoginKubeconfig{Kubeconfig: utils.Ptr(testLoginKubeconfig)},
+			false,
+			types.StringValue("https://api.cluster.example.com"),
+			types.StringValue("ca-certificate"),
+			false,
+		},
+		{
+			"api_error_unknown_values",
+			Model{
+				Endpoint:             types.StringUnknown(),
+				ClusterCACertificate: types.StringUnknown(),
+			},
+			nil,
+			true,
+			types.StringNull(),
+			types.StringNull(),
+			true,
+		},
+		{
+			"empty_kubeconfig",
+			Model{},
+			&ske.LoginKubeconfig{},
+			false,
+			types.StringNull(),
+			types.StringNull(),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &skeClientMocked{
+				getLoginKubeconfigResp:  tt.getLoginKubeconfigResp,
+				getLoginKubeconfigFails: tt.getLoginKubeconfigFails,
+			}
+			var diags diag.Diagnostics
+			loadClusterEndpoint(context.Background(), client, &tt.model, &diags)
+			if diags.HasError() {
+				t.Fatalf("Should not have errors: %v", diags.Errors())
+			}
+			if tt.expectWarning != (diags.WarningsCount() > 0) {
+				t.Fatalf("Expected warning: %t, got diagnostics: %v", tt.expectWarning, diags)
+			}
+			if !tt.model.Endpoint.Equal(tt.expectedEndpoint) {
+				t.Fatalf("Endpoint does not match: expected %v, got %v", tt.expectedEndpoint, tt.model.Endpoint)
+			}
+			if !tt.model.ClusterCACertificate.Equal(tt.expectedCACertificate) {
+				t.Fatalf("CA certificate does not match: expected %v, got %v", tt.expectedCACertificate, tt.model.ClusterCACertificate)
+			}
+		})
+	}
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	internalUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -44,6 +46,7 @@ type Model struct {
 	Kubeconfig   types.String `tfsdk:"kube_config"`
 	Expiration   types.Int64  `tfsdk:"expiration"`
 	Refresh      types.Bool   `tfsdk:"refresh"`
+	Login        types.Bool   `tfsdk:"login"`
 	ExpiresAt    types.String `tfsdk:"expires_at"`
 	CreationTime types.String `tfsdk:"creation_time"`
 }
@@ -55,7 +58,8 @@ func NewKubeconfigResource() resource.Resource {
 
 // kubeconfigResource is the resource implementation.
 type kubeconfigResource struct {
-	client *ske.APIClient
+	client       *ske.APIClient
+	providerData core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -70,7 +74,8 @@ func (r *kubeconfigResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	providerData, ok := req.ProviderData.(core.ProviderData)
+	var ok bool
+	r.providerData, ok = req.ProviderData.(core.ProviderData)
 	if !ok {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring API client", fmt.Sprintf("Expected configure type stackit.ProviderData, got %T", req.ProviderData))
 		return
@@ -78,15 +83,15 @@ func (r *kubeconfigResource) Configure(ctx context.Context, req resource.Configu
 
 	var apiClient *ske.APIClient
 	var err error
-	if providerData.SKECustomEndpoint != "" {
-		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithEndpoint(providerData.SKECustomEndpoint),
+	if r.providerData.SKECustomEndpoint != "" {
+		apiClient, err = core.NewAPIClient(&r.providerData, ske.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithEndpoint(r.providerData.SKECustomEndpoint),
 		)
 	} else {
-		apiClient, err = ske.NewAPIClient(
-			config.WithCustomAuth(providerData.RoundTripper),
-			config.WithRegion(providerData.Region),
+		apiClient, err = core.NewAPIClient(&r.providerData, ske.NewAPIClient,
+			config.WithCustomAuth(r.providerData.RoundTripper),
+			config.WithRegion(r.providerData.Region),
 		)
 	}
 
@@ -107,10 +112,11 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		"kube_config_id": "Internally generated UUID to identify a kubeconfig resource in Terraform, since the SKE API doesnt return a kubeconfig identifier",
 		"cluster_name":   "Name of the SKE cluster.",
 		"project_id":     "STACKIT project ID to which the cluster is associated.",
-		"kube_config":    "Raw short-lived admin kubeconfig.",
-		"expiration":     "Expiration time of the kubeconfig, in seconds. Defaults to `3600`",
-		"expires_at":     "Timestamp when the kubeconfig expires",
+		"kube_config":    "Raw kubeconfig. Short-lived admin kubeconfig, unless `login` is set.",
+		"expiration":     "Expiration time of the kubeconfig, in seconds. Defaults to `3600`. Not used if `login` is set.",
+		"expires_at":     "Timestamp when the kubeconfig expires. Not set if `login` is set.",
 		"refresh":        "If set to true, the provider will check if the kubeconfig has expired and will generated a new valid one in-place",
+		"login":          "If set to true, a kubeconfig that authenticates with the STACKIT CLI (`stackit ske kubeconfig login`) as exec plugin is created, instead of one with embedded admin client certificates. Use it for clusters on which static admin credentials are not allowed. Defaults to `false`.",
 		"creation_time":  "Date-time when the kubeconfig was created",
 	}
 
@@ -171,6 +177,13 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"login": schema.BoolAttribute{
+				Description: descriptions["login"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 			"kube_config": schema.StringAttribute{
 				Description: descriptions["kube_config"],
 				Computed:    true,
@@ -197,13 +210,25 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
-// ModifyPlan will be called in the Plan phase and will check if the plan is a creation of the resource
-// If so, show warning related to deprecated credentials endpoints
+// ModifyPlan will be called in the Plan phase and will check if the plan is a creation of an admin kubeconfig
+// If so, show warning related to deprecated credentials endpoints.
+// It also lists the sensitive attributes persisted to the state, if the audit is enabled.
 func (r *kubeconfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
-	if req.State.Raw.IsNull() {
+	var login types.Bool
+	if !req.Plan.Raw.IsNull() {
+		diags := req.Plan.GetAttribute(ctx, path.Root("login"), &login)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if req.State.Raw.IsNull() && !login.ValueBool() {
 		// Planned to create a kubeconfig
 		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Planned to create kubeconfig", "Once this resource is created, you will no longer be able to use the deprecated credentials endpoints and the kube_config field on the cluster resource will be empty for this cluster. For more info check How to Rotate SKE Credentials (https://docs.stackit.cloud/stackit/en/how-to-rotate-ske-credentials-200016334.html)")
 	}
+	if r.providerData.EnableSensitiveAttributesAudit {
+		internalUtils.AuditSensitiveAttributes(ctx, r, &req, resp)
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -229,6 +254,7 @@ func (r *kubeconfigResource) Create(ctx context.Context, req resource.CreateRequ
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating kubeconfig", fmt.Sprintf("Creating kubeconfig: %v", err))
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Kubeconfig.ValueString())
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -251,6 +277,7 @@ func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Kubeconfig.ValueString())
 
 	projectId := model.ProjectId.ValueString()
 	clusterName := model.ClusterName.ValueString()
@@ -283,10 +310,14 @@ func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	credentialsRotation, err := checkCredentialsRotation(cluster, &model)
-	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading kubeconfig", fmt.Sprintf("%v", err))
-		return
+	// Login kubeconfigs don't contain credentials, so they aren't affected by a credentials rotation
+	credentialsRotation := false
+	if !model.Login.ValueBool() {
+		credentialsRotation, err = checkCredentialsRotation(cluster, &model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading kubeconfig", fmt.Sprintf("%v", err))
+			return
+		}
 	}
 
 	if hasExpired || clusterRecreation || credentialsRotation {
@@ -308,6 +339,20 @@ func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *kubeconfigResource) createKubeconfig(ctx context.Context, model *Model) error {
+	if model.Login.ValueBool() {
+		loginKubeconfigResp, err := r.client.GetLoginKubeconfig(ctx, model.ProjectId.ValueString(), model.ClusterName.ValueString()).Execute()
+		if err != nil {
+			return fmt.Errorf("calling API: %w", err)
+		}
+
+		// Map response body to schema
+		err = mapLoginFields(loginKubeconfigResp, model, time.Now())
+		if err != nil {
+			return fmt.Errorf("processing API payload: %w", err)
+		}
+		return nil
+	}
+
 	// Generate API request body from model
 	payload, err := toCreatePayload(model)
 	if err != nil {
@@ -343,6 +388,7 @@ func (r *kubeconfigResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = core.MaskSensitiveValues(ctx, model.Kubeconfig.ValueString())
 
 	projectId := model.ProjectId.ValueString()
 	clusterName := model.ClusterName.ValueString()
@@ -383,6 +429,35 @@ func mapFields(kubeconfigResp *ske.Kubeconfig, model *Model, creationTime time.T
 	return nil
 }
 
+func mapLoginFields(loginKubeconfigResp *ske.LoginKubeconfig, model *Model, creationTime time.Time) error {
+	if loginKubeconfigResp == nil {
+		return fmt.Errorf("response is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	idParts := []string{
+		model.ProjectId.ValueString(),
+		model.ClusterName.ValueString(),
+		model.KubeconfigId.ValueString(),
+	}
+	model.Id = types.StringValue(
+		strings.Join(idParts, core.Separator),
+	)
+
+	if loginKubeconfigResp.Kubeconfig == nil {
+		return fmt.Errorf("kubeconfig not present")
+	}
+
+	model.Kubeconfig = types.StringPointerValue(loginKubeconfigResp.Kubeconfig)
+	// login kubeconfigs don't expire, the credentials are fetched by the exec plugin
+	model.ExpiresAt = types.StringNull()
+	// set creation time
+	model.CreationTime = types.StringValue(creationTime.Format(time.RFC3339))
+	return nil
+}
+
 func toCreatePayload(model *Model) (*ske.CreateKubeconfigPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -80,6 +80,68 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapLoginFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.LoginKubeconfig
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&ske.LoginKubeconfig{
+				Kubeconfig: utils.Ptr("kubeconfig"),
+			},
+			Model{
+				ClusterName:  types.StringValue("name"),
+				ProjectId:    types.StringValue("pid"),
+				Kubeconfig:   types.StringValue("kubeconfig"),
+				Expiration:   types.Int64Null(),
+				Refresh:      types.BoolNull(),
+				Login:        types.BoolValue(true),
+				ExpiresAt:    types.StringNull(),
+				CreationTime: types.StringValue("2024-02-05T14:40:12Z"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"empty_kubeconfig",
+			&ske.LoginKubeconfig{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:   tt.expected.ProjectId,
+				ClusterName: tt.expected.ClusterName,
+				Login:       tt.expected.Login,
+			}
+			creationTime, _ := time.Parse(time.RFC3339, tt.expected.CreationTime.ValueString())
+			err := mapLoginFields(tt.input, state, creationTime)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected, cmpopts.IgnoreFields(Model{}, "Id")) // Id includes a random uuid
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -55,12 +55,12 @@ func (r *projectDataSource) Configure(ctx context.Context, req datasource.Config
 	var enablementClient *serviceenablement.APIClient
 	var err error
 	if providerData.SKECustomEndpoint != "" {
-		apiClient, err = ske.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
-		apiClient, err = ske.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -72,12 +72,12 @@ func (r *projectDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 
 	if providerData.ServiceEnablementCustomEndpoint != "" {
-		enablementClient, err = serviceenablement.NewAPIClient(
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServiceEnablementCustomEndpoint),
 		)
 	} else {
-		enablementClient, err = serviceenablement.NewAPIClient(
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -103,6 +103,10 @@ func (r *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "Terraform's internal data source. ID. It is structured as \"`project_id`\".",
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT Project ID in which the kubernetes project is enabled.",
 				Required:    true,
@@ -31,6 +31,7 @@ var (
 
 type Model struct {
 	Id        types.String `tfsdk:"id"`
+	ImportId  types.String `tfsdk:"import_id"`
 	ProjectId types.String `tfsdk:"project_id"`
 }
 
@@ -67,12 +68,12 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 	var enablementClient *serviceenablement.APIClient
 	var err error
 	if providerData.SKECustomEndpoint != "" {
-		apiClient, err = ske.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.SKECustomEndpoint),
 		)
 	} else {
-		apiClient, err = ske.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&providerData, ske.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -84,12 +85,12 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 
 	if providerData.ServiceEnablementCustomEndpoint != "" {
-		enablementClient, err = serviceenablement.NewAPIClient(
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithEndpoint(providerData.ServiceEnablementCustomEndpoint),
 		)
 	} else {
-		enablementClient, err = serviceenablement.NewAPIClient(
+		enablementClient, err = core.NewAPIClient(&providerData, serviceenablement.NewAPIClient,
 			config.WithCustomAuth(providerData.RoundTripper),
 			config.WithRegion(providerData.Region),
 		)
@@ -121,6 +122,13 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"project_id": schema.StringAttribute{
 				Description: "STACKIT Project ID in which the kubernetes project is enabled.",
 				Required:    true,
@@ -152,7 +160,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	_, err = enablementWait.EnableServiceWaitHandler(ctx, r.enablementClient, projectId, utils.SKEServiceId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, enablementWait.EnableServiceWaitHandler(ctx, r.enablementClient, projectId, utils.SKEServiceId), "SKE service enablement")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating project", fmt.Sprintf("Wait for SKE enablement: %v", err))
 		return
@@ -181,6 +189,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 	model.Id = types.StringValue(projectId)
+	model.ImportId = model.Id
 	model.ProjectId = types.StringValue(projectId)
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -225,7 +234,7 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err = enablementWait.DisableServiceWaitHandler(ctx, r.enablementClient, projectId, utils.SKEServiceId).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, enablementWait.DisableServiceWaitHandler(ctx, r.enablementClient, projectId, utils.SKEServiceId), "SKE service disablement")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting project", fmt.Sprintf("Wait for SKE disabling: %v", err))
 		return
@@ -58,12 +58,12 @@ func (r *instanceDataSource) Configure(ctx context.Context, req datasource.Confi
 	var apiClient *sqlserverflex.APIClient
 	var err error
 	if r.providerData.SQLServerFlexCustomEndpoint != "" {
-		apiClient, err = sqlserverflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, sqlserverflex.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.SQLServerFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = sqlserverflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, sqlserverflex.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithRegion(r.providerData.Region),
 		)
@@ -83,10 +83,12 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	descriptions := map[string]string{
 		"main":            "SQLServer Flex instance data source schema. Must have a `region` specified in the provider configuration.",
 		"id":              "Terraform's internal data source. ID. It is structured as \"`project_id`,`region`,`instance_id`\".",
+		"import_id":       "Identifier to be used when importing the corresponding resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id":     "ID of the SQLServer Flex instance.",
 		"project_id":      "STACKIT project ID to which the instance is associated.",
 		"name":            "Instance name.",
 		"acl":             "The Access Control List (ACL) for the SQLServer Flex instance.",
+		"status":          "Status of the SQLServer Flex instance, e.g. `READY`.",
 		"backup_schedule": `The backup schedule. Should follow the cron scheduling system format (e.g. "0 0 * * *").`,
 		"options":         "Custom parameters for the SQLServer Flex instance.",
 		"region":          "The resource region. If not defined, the provider region is used.",
@@ -99,6 +101,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Description: descriptions["id"],
 				Computed:    true,
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Required:    true,
@@ -162,6 +168,10 @@ func (r *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"version": schema.StringAttribute{
 				Computed: true,
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"options": schema.SingleNestedAttribute{
 				Description: descriptions["options"],
 				Computed:    true,
@@ -46,6 +46,7 @@ var (
 
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
+	ImportId       types.String `tfsdk:"import_id"`
 	InstanceId     types.String `tfsdk:"instance_id"`
 	ProjectId      types.String `tfsdk:"project_id"`
 	Name           types.String `tfsdk:"name"`
@@ -54,6 +55,7 @@ type Model struct {
 	Flavor         types.Object `tfsdk:"flavor"`
 	Storage        types.Object `tfsdk:"storage"`
 	Version        types.String `tfsdk:"version"`
+	Status         types.String `tfsdk:"status"`
 	Replicas       types.Int64  `tfsdk:"replicas"`
 	Options        types.Object `tfsdk:"options"`
 	Region         types.String `tfsdk:"region"`
@@ -132,12 +134,12 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 	var apiClient *sqlserverflex.APIClient
 	var err error
 	if r.providerData.SQLServerFlexCustomEndpoint != "" {
-		apiClient, err = sqlserverflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, sqlserverflex.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.SQLServerFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = sqlserverflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, sqlserverflex.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithRegion(r.providerData.Region),
 		)
@@ -153,7 +155,8 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
-// Use the modifier to set the effective region in the current plan.
+// Use the modifier to set the effective region in the current plan
+// and to validate the project when planning the creation of the instance, if enabled.
 func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
 	var configModel Model
 	// skip initial empty configuration to avoid follow-up errors
@@ -180,6 +183,8 @@ func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	utils.CheckProjectExists(ctx, &r.providerData, &req, resp)
 }
 
 // Schema defines the schema for the resource.
@@ -187,10 +192,12 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	descriptions := map[string]string{
 		"main":            "SQLServer Flex instance resource schema. Must have a `region` specified in the provider configuration.",
 		"id":              "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`instance_id`\".",
+		"import_id":       "Identifier to be used when importing the resource, e.g. with `terraform import` or in an `import` block.",
 		"instance_id":     "ID of the SQLServer Flex instance.",
 		"project_id":      "STACKIT project ID to which the instance is associated.",
 		"name":            "Instance name.",
 		"acl":             "The Access Control List (ACL) for the SQLServer Flex instance.",
+		"status":          "Status of the SQLServer Flex instance, e.g. `READY`.",
 		"backup_schedule": `The backup schedule. Should follow the cron scheduling system format (e.g. "0 0 * * *")`,
 		"options":         "Custom parameters for the SQLServer Flex instance.",
 		"region":          "The resource region. If not defined, the provider region is used.",
@@ -206,6 +213,13 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"import_id": schema.StringAttribute{
+				Description: descriptions["import_id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"instance_id": schema.StringAttribute{
 				Description: descriptions["instance_id"],
 				Computed:    true,
@@ -318,6 +332,10 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
 			"options": schema.SingleNestedAttribute{
 				Optional: true,
 				Computed: true,
@@ -418,14 +436,14 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new instance
 	createResp, err := r.client.CreateInstance(ctx, projectId, region).CreateInstancePayload(*payload).Execute()
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API: %v", err))
+		core.LogAndAddAPIError(ctx, &resp.Diagnostics, "Error creating instance", err, req.Plan)
 		return
 	}
 	instanceId := *createResp.Id
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 	// The creation waiter sometimes returns an error from the API: "instance with id xxx has unexpected status Failure"
 	// which can be avoided by sleeping before wait
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId, region).SetSleepBeforeWait(30 * time.Second).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId, region).SetSleepBeforeWait(30*time.Second), "SQLServer Flex instance creation")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
 		return
@@ -513,6 +531,10 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	if model.Status.ValueString() == wait.InstanceStateFailed {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "SQLServer Flex instance is in a failed state",
+			"The last operation on the instance has failed. Check the instance in the STACKIT portal and, if it does not recover, recreate it with `terraform apply -replace` (or `terraform taint`).")
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -590,7 +612,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	waitResp, err := wait.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId, region).WaitWithContext(ctx)
+	waitResp, err := utils.Wait(ctx, wait.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId, region), "SQLServer Flex instance update")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
 		return
@@ -632,7 +654,7 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId, region).WaitWithContext(ctx)
+	_, err = utils.Wait(ctx, wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId, region), "SQLServer Flex instance deletion")
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
 		return
@@ -785,6 +807,7 @@ func mapFields(ctx context.Context, resp *sqlserverflex.GetInstanceResponse, mod
 	model.Id = types.StringValue(
 		strings.Join(idParts, core.Separator),
 	)
+	model.ImportId = model.Id
 	model.InstanceId = types.StringValue(instanceId)
 	model.Name = types.StringPointerValue(instance.Name)
 	model.ACL = aclList
@@ -792,6 +815,7 @@ func mapFields(ctx context.Context, resp *sqlserverflex.GetInstanceResponse, mod
 	model.Replicas = types.Int64PointerValue(instance.Replicas)
 	model.Storage = storageObject
 	model.Version = types.StringPointerValue(instance.Version)
+	model.Status = types.StringPointerValue(instance.Status)
 	model.Options = optionsObject
 	model.Region = types.StringValue(region)
 	return nil
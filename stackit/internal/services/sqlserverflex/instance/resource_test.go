@@ -53,6 +53,7 @@ func TestMapFields(t *testing.T) {
 			testRegion,
 			Model{
 				Id:             types.StringValue("pid,region,iid"),
+				ImportId:       types.StringValue("pid,region,iid"),
 				InstanceId:     types.StringValue("iid"),
 				ProjectId:      types.StringValue("pid"),
 				Name:           types.StringNull(),
@@ -121,6 +122,7 @@ func TestMapFields(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid"),
+				ImportId:   types.StringValue("pid,region,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -146,6 +148,7 @@ func TestMapFields(t *testing.T) {
 					"retention_days": types.Int64Value(1),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
 				Region:  types.StringValue(testRegion),
 			},
 			true,
@@ -194,6 +197,7 @@ func TestMapFields(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid"),
+				ImportId:   types.StringValue("pid,region,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -219,6 +223,7 @@ func TestMapFields(t *testing.T) {
 					"retention_days": types.Int64Value(1),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
 				Region:  types.StringValue(testRegion),
 			},
 			true,
@@ -269,6 +274,7 @@ func TestMapFields(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid"),
+				ImportId:   types.StringValue("pid,region,iid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
 				Name:       types.StringValue("name"),
@@ -294,6 +300,7 @@ func TestMapFields(t *testing.T) {
 					"retention_days": types.Int64Value(1),
 				}),
 				Version: types.StringValue("version"),
+				Status:  types.StringValue("status"),
 				Region:  types.StringValue(testRegion),
 			},
 			true,
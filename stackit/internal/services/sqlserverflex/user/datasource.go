@@ -71,12 +71,12 @@ func (r *userDataSource) Configure(ctx context.Context, req datasource.Configure
 	var apiClient *sqlserverflex.APIClient
 	var err error
 	if r.providerData.SQLServerFlexCustomEndpoint != "" {
-		apiClient, err = sqlserverflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, sqlserverflex.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithEndpoint(r.providerData.SQLServerFlexCustomEndpoint),
 		)
 	} else {
-		apiClient, err = sqlserverflex.NewAPIClient(
+		apiClient, err = core.NewAPIClient(&r.providerData, sqlserverflex.NewAPIClient,
 			config.WithCustomAuth(r.providerData.RoundTripper),
 			config.WithRegion(r.providerData.Region),
 		)
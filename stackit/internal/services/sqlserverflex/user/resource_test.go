@@ -30,6 +30,7 @@ func TestMapFieldsCreate(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid,uid"),
+				ImportId:   types.StringValue("pid,region,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -61,6 +62,7 @@ func TestMapFieldsCreate(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid,uid"),
+				ImportId:   types.StringValue("pid,region,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -92,6 +94,7 @@ func TestMapFieldsCreate(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid,uid"),
+				ImportId:   types.StringValue("pid,region,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -179,6 +182,7 @@ func TestMapFields(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid,uid"),
+				ImportId:   types.StringValue("pid,region,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -207,6 +211,7 @@ func TestMapFields(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid,uid"),
+				ImportId:   types.StringValue("pid,region,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
@@ -236,6 +241,7 @@ func TestMapFields(t *testing.T) {
 			testRegion,
 			Model{
 				Id:         types.StringValue("pid,region,iid,uid"),
+				ImportId:   types.StringValue("pid,region,iid,uid"),
 				UserId:     types.StringValue("uid"),
 				InstanceId: types.StringValue("iid"),
 				ProjectId:  types.StringValue("pid"),
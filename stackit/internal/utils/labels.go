@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// systemLabelDomain is the domain under which STACKIT services attach labels on their own.
+const systemLabelDomain = "stackit.cloud"
+
+// IsSystemLabel reports whether a label key is reserved for labels that STACKIT attaches itself,
+// i.e. it is prefixed with the "stackit.cloud" domain or one of its subdomains, e.g. "ske.stackit.cloud/cluster".
+func IsSystemLabel(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return false
+	}
+	return prefix == systemLabelDomain || strings.HasSuffix(prefix, "."+systemLabelDomain)
+}
+
+// MapLabels maps the labels returned by the API to the value of a labels attribute.
+// System labels are left out, as they can't be managed by the user and would otherwise show up as drift.
+// If no labels are left, the result is null, unless current is an empty map, in which case the empty map is kept.
+func MapLabels(ctx context.Context, labels *map[string]interface{}, current types.Map) (types.Map, error) {
+	userLabels := map[string]interface{}{}
+	if labels != nil {
+		for k, v := range *labels {
+			if IsSystemLabel(k) {
+				continue
+			}
+			userLabels[k] = v
+		}
+	}
+	if len(userLabels) == 0 && current.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+
+	labelsTF, diags := types.MapValueFrom(ctx, types.StringType, userLabels)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
+	}
+	return labelsTF, nil
+}
+
+// MergeDefaultLabels merges the default labels of the provider into the labels of a resource.
+// Labels of the resource take precedence. The result is null if both are empty and labels is null.
+func MergeDefaultLabels(defaultLabels map[string]string, labels types.Map) (types.Map, error) {
+	if len(defaultLabels) == 0 || labels.IsUnknown() {
+		return labels, nil
+	}
+	merged := make(map[string]attr.Value, len(defaultLabels))
+	for k, v := range defaultLabels {
+		merged[k] = types.StringValue(v)
+	}
+	for k, v := range labels.Elements() {
+		merged[k] = v
+	}
+	mergedTF, diags := types.MapValue(types.StringType, merged)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), fmt.Errorf("converting labels to StringValue map: %w", core.DiagsToError(diags))
+	}
+	return mergedTF, nil
+}
+
+// ModifyPlanLabels sets the planned labels attribute of a resource to its configured labels merged with the default
+// labels of the provider, so that adding or changing default labels shows up in the plan and updates the resource.
+// The labels attribute must be computed.
+func ModifyPlanLabels(ctx context.Context, defaultLabels map[string]string, req *resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The plan is empty if the resource is deleted
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	p := path.Root("labels")
+	var configLabels types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &configLabels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planLabels, err := MergeDefaultLabels(defaultLabels, configLabels)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error merging default labels", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, planLabels)...)
+}
+
+// RemoveIgnoredLabels removes the labels whose key starts with one of the ignored prefixes of the provider from the
+// labels returned by the API, unless they are also set in the labels attribute of the resource, so that labels managed
+// outside of Terraform (e.g. by cost tooling) don't show up as drift. Since the labels are updated partially, the
+// ignored labels are kept by updates.
+func RemoveIgnoredLabels[V any](ignoredPrefixes []string, labels *map[string]V, current types.Map) *map[string]V {
+	if len(ignoredPrefixes) == 0 || labels == nil {
+		return labels
+	}
+	currentLabels := current.Elements()
+	filtered := map[string]V{}
+	for k, v := range *labels {
+		if _, managed := currentLabels[k]; !managed && hasAnyPrefix(k, ignoredPrefixes) {
+			continue
+		}
+		filtered[k] = v
+	}
+	return &filtered
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
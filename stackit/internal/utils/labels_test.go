@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsSystemLabel(t *testing.T) {
+	tests := []struct {
+		description string
+		key         string
+		expected    bool
+	}{
+		{"user label", "env", false},
+		{"system domain", "stackit.cloud/managed-by", true},
+		{"system subdomain", "ske.stackit.cloud/cluster", true},
+		{"other domain", "example.com/owner", false},
+		{"lookalike domain", "notstackit.cloud/owner", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := IsSystemLabel(tt.key); got != tt.expected {
+				t.Fatalf("IsSystemLabel(%q) = %v, expected %v", tt.key, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapLabels(t *testing.T) {
+	tests := []struct {
+		description string
+		labels      *map[string]interface{}
+		current     types.Map
+		expected    types.Map
+	}{
+		{
+			"nil labels, null state",
+			nil,
+			types.MapNull(types.StringType),
+			types.MapNull(types.StringType),
+		},
+		{
+			"nil labels, empty state",
+			nil,
+			types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+		{
+			"user labels",
+			&map[string]interface{}{
+				"env": "prod",
+			},
+			types.MapNull(types.StringType),
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+		},
+		{
+			"system labels are dropped",
+			&map[string]interface{}{
+				"env":                       "prod",
+				"ske.stackit.cloud/cluster": "my-cluster",
+			},
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+		},
+		{
+			"only system labels",
+			&map[string]interface{}{
+				"stackit.cloud/managed-by": "ske",
+			},
+			types.MapNull(types.StringType),
+			types.MapNull(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := MapLabels(context.Background(), tt.labels, tt.current)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(got, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeDefaultLabels(t *testing.T) {
+	defaultLabels := map[string]string{
+		"cost-center": "1234",
+		"team":        "platform",
+	}
+	tests := []struct {
+		description   string
+		defaultLabels map[string]string
+		labels        types.Map
+		expected      types.Map
+	}{
+		{
+			"no default labels",
+			nil,
+			types.MapNull(types.StringType),
+			types.MapNull(types.StringType),
+		},
+		{
+			"null labels",
+			defaultLabels,
+			types.MapNull(types.StringType),
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"cost-center": types.StringValue("1234"),
+				"team":        types.StringValue("platform"),
+			}),
+		},
+		{
+			"resource labels take precedence",
+			defaultLabels,
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":  types.StringValue("prod"),
+				"team": types.StringValue("data"),
+			}),
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"cost-center": types.StringValue("1234"),
+				"env":         types.StringValue("prod"),
+				"team":        types.StringValue("data"),
+			}),
+		},
+		{
+			"unknown labels",
+			defaultLabels,
+			types.MapUnknown(types.StringType),
+			types.MapUnknown(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := MergeDefaultLabels(tt.defaultLabels, tt.labels)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !output.Equal(tt.expected) {
+				t.Fatalf("Expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestRemoveIgnoredLabels(t *testing.T) {
+	ignoredPrefixes := []string{"stackit.cloud/", "cost-"}
+	tests := []struct {
+		description     string
+		ignoredPrefixes []string
+		labels          *map[string]interface{}
+		current         types.Map
+		expected        *map[string]interface{}
+	}{
+		{
+			"no ignored prefixes",
+			nil,
+			&map[string]interface{}{"cost-center": "1234"},
+			types.MapNull(types.StringType),
+			&map[string]interface{}{"cost-center": "1234"},
+		},
+		{
+			"nil labels",
+			ignoredPrefixes,
+			nil,
+			types.MapNull(types.StringType),
+			nil,
+		},
+		{
+			"ignored labels removed",
+			ignoredPrefixes,
+			&map[string]interface{}{"cost-center": "1234", "stackit.cloud/owner": "ske", "env": "prod"},
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+			&map[string]interface{}{"env": "prod"},
+		},
+		{
+			"ignored labels set on the resource are kept",
+			ignoredPrefixes,
+			&map[string]interface{}{"cost-center": "1234", "stackit.cloud/owner": "ske"},
+			types.MapValueMust(types.StringType, map[string]attr.Value{
+				"cost-center": types.StringValue("1234"),
+			}),
+			&map[string]interface{}{"cost-center": "1234"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := RemoveIgnoredLabels(tt.ignoredPrefixes, tt.labels, tt.current)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// sensitiveHeaders are the HTTP headers whose values are redacted in the API logs.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+type loggingRoundTripper struct {
+	next http.RoundTripper
+}
+
+// NewLoggingRoundTripper returns a round tripper which logs each API request and response, including headers and
+// bodies, on debug level. Sensitive headers and the values of sensitive JSON fields are redacted, bodies which are
+// not JSON are omitted.
+func NewLoggingRoundTripper(next http.RoundTripper) http.RoundTripper {
+	return &loggingRoundTripper{
+		next: next,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *loggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, "API request", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    redactBody(reqBody),
+	})
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		tflog.Debug(ctx, "API request failed", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"error":  err.Error(),
+		})
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	tflog.Debug(ctx, "API response", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"status":  resp.StatusCode,
+		"headers": redactHeaders(resp.Header),
+		"body":    redactBody(respBody),
+	})
+	return resp, nil
+}
+
+// readRequestBody returns the body of the request, leaving the request ready to be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("copying request body: %w", err)
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	reqBody, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	return reqBody, nil
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := map[string]string{}
+	for name, values := range headers {
+		value := strings.Join(values, ", ")
+		for _, sensitiveHeader := range sensitiveHeaders {
+			if strings.EqualFold(name, sensitiveHeader) {
+				value = core.RedactedValue
+				break
+			}
+		}
+		result[name] = value
+	}
+	return result
+}
+
+// redactBody returns the body for the API logs, with the values of sensitive JSON fields redacted.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var content interface{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return fmt.Sprintf("[%d bytes omitted, not JSON]", len(body))
+	}
+	redacted, err := json.Marshal(core.RedactJSON(content))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes omitted]", len(body))
+	}
+	return string(redacted)
+}
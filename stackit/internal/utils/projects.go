@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// AdaptProjectId rewrites the project ID of a terraform plan
+func AdaptProjectId(ctx context.Context, configProjectId types.String, planProjectId *types.String, defaultProjectId string, resp *resource.ModifyPlanResponse) {
+	// The project ID may reference another resource and only be known after apply
+	if configProjectId.IsUnknown() {
+		return
+	}
+
+	// Get the intended project ID. This is either set directly in the individual
+	// config or the provider default project ID has to be used
+	var intendedProjectId types.String
+	if configProjectId.IsNull() {
+		if defaultProjectId == "" {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "set project ID", "no project_id defined in config and no default_project_id defined in provider")
+			return
+		}
+		intendedProjectId = types.StringValue(defaultProjectId)
+	} else {
+		intendedProjectId = configProjectId
+	}
+
+	// check if the currently configured project ID corresponds to the planned project ID
+	// on mismatch override the planned project ID with the intended project ID
+	// and force a replace of the resource
+	p := path.Root("project_id")
+	if !intendedProjectId.Equal(*planProjectId) {
+		resp.RequiresReplace.Append(p)
+		*planProjectId = intendedProjectId
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, *planProjectId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CheckProjectExists adds an error to the plan if the project of a resource which is planned to be created doesn't
+// exist, can't be accessed or isn't active, so that a wrong project ID is reported before anything is applied.
+// It is meant to be called from ModifyPlan and does nothing unless the project validation is enabled in the provider
+// configuration. Each project is requested only once per provider instance.
+func CheckProjectExists(ctx context.Context, providerData *core.ProviderData, req *resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !providerData.EnableProjectValidation || !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var projectId types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	if resp.Diagnostics.HasError() || projectId.IsUnknown() || projectId.IsNull() {
+		return
+	}
+
+	var client *resourcemanager.APIClient
+	var err error
+	if providerData.ResourceManagerCustomEndpoint != "" {
+		client, err = core.NewAPIClient(providerData, resourcemanager.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+			config.WithEndpoint(providerData.ResourceManagerCustomEndpoint),
+		)
+	} else {
+		client, err = core.NewAPIClient(providerData, resourcemanager.NewAPIClient,
+			config.WithCustomAuth(providerData.RoundTripper),
+		)
+	}
+	if err != nil {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Project not validated", fmt.Sprintf("Configuring Resource Manager client: %v", err))
+		return
+	}
+
+	key := strings.Join([]string{"resourcemanager_project", projectId.ValueString()}, core.Separator)
+	projectResp, err := core.CachedResponse(providerData.ResponseCache, key, func() (*resourcemanager.GetProjectResponse, error) {
+		return client.GetProject(ctx, projectId.ValueString()).Execute()
+	})
+	warning, err := checkProject(projectId.ValueString(), projectResp, err)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Invalid project", err.Error())
+		return
+	}
+	if warning != "" {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Project not validated", warning)
+	}
+}
+
+// checkProject returns an error if the project doesn't exist, can't be accessed or isn't active. If the project
+// couldn't be requested for another reason, e.g. a network error, a warning is returned instead.
+func checkProject(projectId string, projectResp *resourcemanager.GetProjectResponse, apiErr error) (warning string, err error) {
+	if apiErr != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(apiErr, &oapiErr) && (oapiErr.StatusCode == http.StatusNotFound || oapiErr.StatusCode == http.StatusForbidden) {
+			return "", fmt.Errorf("the project %q doesn't exist or the credentials of the provider have no access to it, check the project ID", projectId)
+		}
+		return fmt.Sprintf("The project %q couldn't be requested: %v", projectId, apiErr), nil
+	}
+	if projectResp == nil || projectResp.LifecycleState == nil {
+		return "", nil
+	}
+	state := *projectResp.LifecycleState
+	if state != resourcemanager.LIFECYCLESTATE_ACTIVE && state != resourcemanager.LIFECYCLESTATE_CREATING {
+		return "", fmt.Errorf("the project %q is in state %q, resources can only be created in active projects", projectId, state)
+	}
+	return "", nil
+}
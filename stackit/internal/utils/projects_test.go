@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
+)
+
+func TestAdaptProjectId(t *testing.T) {
+	type model struct {
+		ProjectId types.String `tfsdk:"project_id"`
+	}
+	type args struct {
+		configProjectId  types.String
+		defaultProjectId string
+	}
+	testcases := []struct {
+		name          string
+		args          args
+		wantErr       bool
+		wantProjectId types.String
+	}{
+		{
+			"no configured project ID, use provider default project ID",
+			args{
+				types.StringNull(),
+				"pid",
+			},
+			false,
+			types.StringValue("pid"),
+		},
+		{
+			"no configured project ID, no provider default project ID => want error",
+			args{
+				types.StringNull(),
+				"",
+			},
+			true,
+			types.StringNull(),
+		},
+		{
+			"configuration project ID overrides provider default project ID",
+			args{
+				types.StringValue("other-pid"),
+				"pid",
+			},
+			false,
+			types.StringValue("other-pid"),
+		},
+		{
+			"unknown configuration project ID is kept",
+			args{
+				types.StringUnknown(),
+				"pid",
+			},
+			false,
+			types.StringNull(),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := tfsdk.Plan{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			}
+
+			if diags := plan.Set(context.Background(), model{types.StringValue("unknown")}); diags.HasError() {
+				t.Fatalf("cannot create test model: %v", diags)
+			}
+			resp := resource.ModifyPlanResponse{
+				Plan: plan,
+			}
+
+			configModel := model{
+				ProjectId: tc.args.configProjectId,
+			}
+			planModel := model{}
+			AdaptProjectId(context.Background(), configModel.ProjectId, &planModel.ProjectId, tc.args.defaultProjectId, &resp)
+			if diags := resp.Diagnostics; tc.wantErr != diags.HasError() {
+				t.Errorf("unexpected diagnostics: want err: %v, actual %v", tc.wantErr, diags.Errors())
+			}
+			if expected, actual := tc.wantProjectId, planModel.ProjectId; !expected.Equal(actual) {
+				t.Errorf("wrong result project ID. expect %s but got %s", expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckProject(t *testing.T) {
+	activeState := resourcemanager.LIFECYCLESTATE_ACTIVE
+	creatingState := resourcemanager.LIFECYCLESTATE_CREATING
+	deletingState := resourcemanager.LIFECYCLESTATE_DELETING
+	tests := []struct {
+		description     string
+		projectResp     *resourcemanager.GetProjectResponse
+		apiErr          error
+		expectedWarning bool
+		isValid         bool
+	}{
+		{
+			"active",
+			&resourcemanager.GetProjectResponse{LifecycleState: &activeState},
+			nil,
+			false,
+			true,
+		},
+		{
+			"creating",
+			&resourcemanager.GetProjectResponse{LifecycleState: &creatingState},
+			nil,
+			false,
+			true,
+		},
+		{
+			"no_state",
+			&resourcemanager.GetProjectResponse{},
+			nil,
+			false,
+			true,
+		},
+		{
+			"deleting",
+			&resourcemanager.GetProjectResponse{LifecycleState: &deletingState},
+			nil,
+			false,
+			false,
+		},
+		{
+			"not_found",
+			nil,
+			&oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound},
+			false,
+			false,
+		},
+		{
+			"forbidden",
+			nil,
+			&oapierror.GenericOpenAPIError{StatusCode: http.StatusForbidden},
+			false,
+			false,
+		},
+		{
+			"server_error",
+			nil,
+			&oapierror.GenericOpenAPIError{StatusCode: http.StatusInternalServerError},
+			true,
+			true,
+		},
+		{
+			"network_error",
+			nil,
+			fmt.Errorf("connection refused"),
+			true,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			warning, err := checkProject("pid", tt.projectResp, tt.apiErr)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.expectedWarning != (warning != "") {
+				t.Fatalf("Expected warning to be %v, got %q", tt.expectedWarning, warning)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// proxySchemes are the URL schemes supported for the proxy.
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// ParseProxyUrl parses the URL of the proxy configured in the provider block.
+func ParseProxyUrl(proxyUrl string) (*url.URL, error) {
+	u, err := url.Parse(proxyUrl)
+	if err != nil {
+		return nil, fmt.Errorf("parsing proxy URL: %w", err)
+	}
+	if !slices.Contains(proxySchemes, u.Scheme) {
+		return nil, fmt.Errorf("proxy URL %q has unsupported scheme %q, supported are %v", proxyUrl, u.Scheme, proxySchemes)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy URL %q has no host", proxyUrl)
+	}
+	return u, nil
+}
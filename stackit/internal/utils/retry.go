@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+// Bounds of the retries done by ReadAfterWrite. They are variables so that tests can shorten them.
+var (
+	readAfterWriteMaxAttempts = 6
+	readAfterWriteBaseDelay   = 1 * time.Second
+	readAfterWriteMaxDelay    = 10 * time.Second
+)
+
+// ReadAfterWrite calls read until it reports the resource as found, retrying with exponential backoff and jitter.
+// Some APIs are eventually consistent, so a resource might not be returned right after it has been created.
+// A "not found" API error returned by read is handled like a resource which was not found yet,
+// any other error is returned immediately.
+func ReadAfterWrite(ctx context.Context, read func() (found bool, err error)) error {
+	delay := readAfterWriteBaseDelay
+	for attempt := 1; ; attempt++ {
+		found, err := read()
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		if err == nil && found {
+			return nil
+		}
+		if attempt >= readAfterWriteMaxAttempts {
+			if err != nil {
+				return fmt.Errorf("resource not found after %d attempts: %w", attempt, err)
+			}
+			return fmt.Errorf("resource not found after %d attempts", attempt)
+		}
+
+		wait := delay + rand.N(delay/2+1) //nolint:gosec // jitter does not need a cryptographically secure random number
+		tflog.Debug(ctx, fmt.Sprintf("Resource not found yet, retrying read in %s (attempt %d of %d)", wait, attempt, readAfterWriteMaxAttempts))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		delay = min(2*delay, readAfterWriteMaxDelay)
+	}
+}
+
+func isNotFoundError(err error) bool {
+	var oapiErr *oapierror.GenericOpenAPIError
+	return errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound
+}
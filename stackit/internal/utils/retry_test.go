@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+)
+
+func TestReadAfterWrite(t *testing.T) {
+	readAfterWriteBaseDelay = time.Millisecond
+	readAfterWriteMaxDelay = 2 * time.Millisecond
+
+	notFoundErr := &oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound}
+	otherErr := &oapierror.GenericOpenAPIError{StatusCode: http.StatusInternalServerError}
+
+	tests := []struct {
+		description      string
+		results          []error
+		foundOnAttempt   int
+		expectedAttempts int
+		isValid          bool
+	}{
+		{
+			"found_immediately",
+			nil,
+			1,
+			1,
+			true,
+		},
+		{
+			"found_after_not_found_errors",
+			[]error{notFoundErr, notFoundErr},
+			3,
+			3,
+			true,
+		},
+		{
+			"found_after_not_found_results",
+			nil,
+			4,
+			4,
+			true,
+		},
+		{
+			"wrapped_not_found_error",
+			[]error{fmt.Errorf("wrapped: %w", notFoundErr)},
+			2,
+			2,
+			true,
+		},
+		{
+			"other_error",
+			[]error{otherErr},
+			2,
+			1,
+			false,
+		},
+		{
+			"never_found",
+			nil,
+			0,
+			readAfterWriteMaxAttempts,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			attempts := 0
+			err := ReadAfterWrite(context.Background(), func() (bool, error) {
+				attempts++
+				if attempts <= len(tt.results) {
+					return false, tt.results[attempts-1]
+				}
+				return attempts == tt.foundOnAttempt, nil
+			})
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Fatalf("Expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
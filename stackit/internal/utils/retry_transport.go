@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Defaults of the retry policy, used for the settings which are not configured in the provider block.
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried if no status codes are configured in the provider block.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryBaseDelay is the delay before the first retry. It is a variable so that tests can shorten it.
+var retryBaseDelay = 1 * time.Second
+
+// idempotentMethods are the HTTP methods which are retried for every retryable status code.
+var idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
+type retryRoundTripper struct {
+	next        http.RoundTripper
+	maxAttempts int
+	maxBackoff  time.Duration
+	statusCodes []int
+}
+
+// NewRetryRoundTripper returns a round tripper which retries requests answered with one of the given status codes,
+// with exponential backoff and jitter capped at maxBackoff, until maxAttempts requests have been sent.
+// Requests which are not idempotent (e.g. a POST creating a resource) are only retried on 429 Too Many Requests,
+// since the server may have processed them before failing with any other status code.
+// If the response has a Retry-After header, the next attempt is not sent before the given time. Requests are
+// not retried if the server asks to wait longer than maxBackoff.
+func NewRetryRoundTripper(next http.RoundTripper, maxAttempts int, maxBackoff time.Duration, statusCodes []int) http.RoundTripper {
+	return &retryRoundTripper{
+		next:        next,
+		maxAttempts: maxAttempts,
+		maxBackoff:  maxBackoff,
+		statusCodes: statusCodes,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	delay := min(retryBaseDelay, rt.maxBackoff)
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		resp, err := rt.next.RoundTrip(attemptReq)
+		if err != nil || attempt >= rt.maxAttempts || !rt.isRetryable(req, resp) {
+			return resp, err
+		}
+
+		wait := min(delay+rand.N(delay/2+1), rt.maxBackoff) //nolint:gosec // jitter does not need a cryptographically secure random number
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if retryAfter > rt.maxBackoff {
+				return resp, nil
+			}
+			wait = max(wait, retryAfter)
+		}
+
+		// The body of the original request has been consumed, it can only be retried if it can be recreated
+		nextReq := req.Clone(ctx)
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			nextReq.Body, err = req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+		}
+		// Drain the body so that the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		tflog.Debug(ctx, fmt.Sprintf("Request %s %s failed with status %d, retrying in %s (attempt %d of %d)", req.Method, req.URL.Path, resp.StatusCode, wait, attempt, rt.maxAttempts))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		delay = min(2*delay, rt.maxBackoff)
+		attemptReq = nextReq
+	}
+}
+
+func (rt *retryRoundTripper) isRetryable(req *http.Request, resp *http.Response) bool {
+	if !slices.Contains(rt.statusCodes, resp.StatusCode) {
+		return false
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || slices.Contains(idempotentMethods, req.Method)
+}
+
+// parseRetryAfter returns the time to wait given by a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(date.Sub(now), 0), true
+}
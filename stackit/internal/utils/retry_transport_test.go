@@ -0,0 +1,216 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryRoundTripper(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+
+	tests := []struct {
+		description      string
+		method           string
+		body             string
+		statusCodes      []int
+		expectedAttempts int
+		expectedStatus   int
+	}{
+		{
+			"success",
+			http.MethodGet,
+			"",
+			[]int{http.StatusOK},
+			1,
+			http.StatusOK,
+		},
+		{
+			"retry_until_success",
+			http.MethodGet,
+			"",
+			[]int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			3,
+			http.StatusOK,
+		},
+		{
+			"retry_with_body",
+			http.MethodPut,
+			"payload",
+			[]int{http.StatusInternalServerError, http.StatusOK},
+			2,
+			http.StatusOK,
+		},
+		{
+			"max_attempts_reached",
+			http.MethodGet,
+			"",
+			[]int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			3,
+			http.StatusServiceUnavailable,
+		},
+		{
+			"status_not_retryable",
+			http.MethodGet,
+			"",
+			[]int{http.StatusBadRequest, http.StatusOK},
+			1,
+			http.StatusBadRequest,
+		},
+		{
+			"post_not_retried_on_server_error",
+			http.MethodPost,
+			"payload",
+			[]int{http.StatusInternalServerError, http.StatusOK},
+			1,
+			http.StatusInternalServerError,
+		},
+		{
+			"post_retried_on_too_many_requests",
+			http.MethodPost,
+			"payload",
+			[]int{http.StatusTooManyRequests, http.StatusOK},
+			2,
+			http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("Reading request body: %v", err)
+				}
+				if string(body) != tt.body {
+					t.Errorf("Request body does not match: expected %q, got %q", tt.body, string(body))
+				}
+				w.WriteHeader(tt.statusCodes[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: NewRetryRoundTripper(http.DefaultTransport, 3, 10*time.Millisecond, DefaultRetryableStatusCodes),
+			}
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Creating request: %v", err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Status code does not match: expected %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Fatalf("Number of attempts does not match: expected %d, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryRoundTripperRetryAfter(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+
+	tests := []struct {
+		description      string
+		retryAfter       string
+		maxBackoff       time.Duration
+		expectedAttempts int
+		expectedStatus   int
+		expectedMinWait  time.Duration
+	}{
+		{
+			"retry_after_respected",
+			"1",
+			2 * time.Second,
+			2,
+			http.StatusOK,
+			time.Second,
+		},
+		{
+			"retry_after_exceeds_max_backoff",
+			"60",
+			2 * time.Second,
+			1,
+			http.StatusTooManyRequests,
+			0,
+		},
+		{
+			"invalid_retry_after_ignored",
+			"soon",
+			10 * time.Millisecond,
+			2,
+			http.StatusOK,
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				attempts++
+				if attempts == 1 {
+					w.Header().Set("Retry-After", tt.retryAfter)
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: NewRetryRoundTripper(http.DefaultTransport, 3, tt.maxBackoff, DefaultRetryableStatusCodes),
+			}
+			start := time.Now()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.expectedStatus {
+				t.Fatalf("Status code does not match: expected %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if attempts != tt.expectedAttempts {
+				t.Fatalf("Number of attempts does not match: expected %d, got %d", tt.expectedAttempts, attempts)
+			}
+			if elapsed := time.Since(start); elapsed < tt.expectedMinWait {
+				t.Fatalf("Expected to wait at least %s, waited %s", tt.expectedMinWait, elapsed)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		value       string
+		expected    time.Duration
+		isValid     bool
+	}{
+		{"empty", "", 0, false},
+		{"seconds", "30", 30 * time.Second, true},
+		{"negative_seconds", "-1", 0, false},
+		{"http_date", "Wed, 01 Jan 2025 12:00:10 GMT", 10 * time.Second, true},
+		{"http_date_in_the_past", "Wed, 01 Jan 2025 11:00:00 GMT", 0, true},
+		{"invalid", "soon", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, ok := parseRetryAfter(tt.value, now)
+			if ok != tt.isValid {
+				t.Fatalf("Expected valid to be %v, got %v", tt.isValid, ok)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// AuditSensitiveAttributes adds a warning to the plan listing the sensitive attributes of the given resource which
+// are created or changed by the plan, and will therefore be persisted to the Terraform state. It is meant to be called
+// from ModifyPlan when the sensitive attributes audit is enabled in the provider configuration.
+func AuditSensitiveAttributes(ctx context.Context, r resource.Resource, req *resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing will be persisted to the state if the resource is destroyed
+	if resp.Plan.Raw.IsNull() {
+		return
+	}
+
+	metadataResp := resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "stackit"}, &metadataResp)
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	sensitivePaths := ChangedAttributePaths(SensitiveAttributePaths(schemaResp.Schema), resp.Plan.Raw, req.State.Raw)
+	if len(sensitivePaths) == 0 {
+		return
+	}
+	core.LogAndAddWarning(ctx, &resp.Diagnostics, fmt.Sprintf("Sensitive attributes of %s are persisted to the state", metadataResp.TypeName),
+		fmt.Sprintf("The following sensitive attributes of %q will be stored in the Terraform state: %s. Make sure the state is stored and shared securely.", metadataResp.TypeName, strings.Join(sensitivePaths, ", ")))
+}
+
+// ChangedAttributePaths returns the paths, as returned by SensitiveAttributePaths, whose top-level attribute differs
+// between the plan and the state. All paths are returned if the resource is created, i.e. the state is null.
+func ChangedAttributePaths(paths []string, plan, state tftypes.Value) []string {
+	if state.IsNull() {
+		return paths
+	}
+	changed := []string{}
+	for _, p := range paths {
+		name, _, _ := strings.Cut(p, ".")
+		attributePath := tftypes.NewAttributePath().WithAttributeName(name)
+		planValue, _, planErr := tftypes.WalkAttributePath(plan, attributePath)
+		stateValue, _, stateErr := tftypes.WalkAttributePath(state, attributePath)
+		if planErr != nil || stateErr != nil {
+			changed = append(changed, p)
+			continue
+		}
+		planAttribute, planOk := planValue.(tftypes.Value)
+		stateAttribute, stateOk := stateValue.(tftypes.Value)
+		if !planOk || !stateOk || !planAttribute.Equal(stateAttribute) {
+			changed = append(changed, p)
+		}
+	}
+	return changed
+}
+
+// SensitiveAttributePaths returns the sorted paths of all attributes of the schema which are marked as sensitive,
+// including attributes of nested objects, e.g. "password" or "credentials.secret_key".
+func SensitiveAttributePaths(s schema.Schema) []string {
+	paths := sensitiveAttributePaths("", s.Attributes)
+	sort.Strings(paths)
+	return paths
+}
+
+func sensitiveAttributePaths(prefix string, attributes map[string]schema.Attribute) []string {
+	paths := []string{}
+	for name, attribute := range attributes {
+		attributePath := prefix + name
+		if attribute.IsSensitive() {
+			paths = append(paths, attributePath)
+			continue
+		}
+		var nestedAttributes map[string]schema.Attribute
+		switch a := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			nestedAttributes = a.Attributes
+		case schema.ListNestedAttribute:
+			nestedAttributes = a.NestedObject.Attributes
+		case schema.SetNestedAttribute:
+			nestedAttributes = a.NestedObject.Attributes
+		case schema.MapNestedAttribute:
+			nestedAttributes = a.NestedObject.Attributes
+		}
+		paths = append(paths, sensitiveAttributePaths(attributePath+".", nestedAttributes)...)
+	}
+	return paths
+}
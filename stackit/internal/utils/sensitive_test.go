@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSensitiveAttributePaths(t *testing.T) {
+	tests := []struct {
+		description string
+		input       schema.Schema
+		expected    []string
+	}{
+		{
+			"no_sensitive_attributes",
+			schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Required: true},
+				},
+			},
+			[]string{},
+		},
+		{
+			"top_level_attributes",
+			schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":       schema.StringAttribute{Computed: true},
+					"password": schema.StringAttribute{Computed: true, Sensitive: true},
+					"uri":      schema.StringAttribute{Computed: true, Sensitive: true},
+				},
+			},
+			[]string{"password", "uri"},
+		},
+		{
+			"nested_attributes",
+			schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"secret": schema.StringAttribute{Sensitive: true},
+							"public": schema.StringAttribute{},
+						},
+					},
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"key": schema.StringAttribute{Sensitive: true},
+							},
+						},
+					},
+					"set": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"token": schema.StringAttribute{Sensitive: true},
+							},
+						},
+					},
+				},
+			},
+			[]string{"list.key", "set.token", "single.secret"},
+		},
+		{
+			"sensitive_nested_attribute",
+			schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"credentials": schema.SingleNestedAttribute{
+						Sensitive: true,
+						Attributes: map[string]schema.Attribute{
+							"secret": schema.StringAttribute{Sensitive: true},
+						},
+					},
+				},
+			},
+			[]string{"credentials"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := SensitiveAttributePaths(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangedAttributePaths(t *testing.T) {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":     tftypes.String,
+			"password": tftypes.String,
+			"credentials": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"secret": tftypes.String,
+				},
+			},
+		},
+	}
+	credentialsType := objectType.AttributeTypes["credentials"]
+	value := func(name, password, secret any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"password": tftypes.NewValue(tftypes.String, password),
+			"credentials": tftypes.NewValue(credentialsType, map[string]tftypes.Value{
+				"secret": tftypes.NewValue(tftypes.String, secret),
+			}),
+		})
+	}
+	paths := []string{"credentials.secret", "password"}
+
+	tests := []struct {
+		description string
+		plan        tftypes.Value
+		state       tftypes.Value
+		expected    []string
+	}{
+		{
+			"create",
+			value("name", tftypes.UnknownValue, tftypes.UnknownValue),
+			tftypes.NewValue(objectType, nil),
+			[]string{"credentials.secret", "password"},
+		},
+		{
+			"no_change",
+			value("name", "password", "secret"),
+			value("name", "password", "secret"),
+			[]string{},
+		},
+		{
+			"other_attribute_changed",
+			value("new-name", "password", "secret"),
+			value("name", "password", "secret"),
+			[]string{},
+		},
+		{
+			"top_level_attribute_changed",
+			value("name", tftypes.UnknownValue, "secret"),
+			value("name", "password", "secret"),
+			[]string{"password"},
+		},
+		{
+			"nested_attribute_changed",
+			value("name", "password", "new-secret"),
+			value("name", "password", "secret"),
+			[]string{"credentials.secret"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := ChangedAttributePaths(paths, tt.plan, tt.state)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+// Operations of a resource which can be given a timeout in the timeouts block
+const (
+	TimeoutCreate = "create"
+	TimeoutUpdate = "update"
+	TimeoutDelete = "delete"
+)
+
+// TimeoutsTypes are the types of the timeouts block
+var TimeoutsTypes = map[string]attr.Type{
+	TimeoutCreate: types.StringType,
+	TimeoutUpdate: types.StringType,
+	TimeoutDelete: types.StringType,
+}
+
+// durationRegex matches the durations accepted by time.ParseDuration, e.g. "90m" or "1h30m"
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// TimeoutsBlock returns the schema of the timeouts block of resources with long-running operations.
+// It is a block, like the one of the terraform-plugin-framework-timeouts module, so that it is configured as `timeouts { ... }`.
+// If the timeout of an operation is not set, the default timeout of the resource is used.
+func TimeoutsBlock() schema.SingleNestedBlock {
+	timeoutAttribute := func(operation string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: fmt.Sprintf("Timeout for the %s operation, e.g. `90m`. Overrides the default timeout of the resource.", operation),
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationRegex, "value must be a duration, e.g. `30s`, `90m` or `2h`"),
+			},
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: "Timeouts for the long-running operations of the resource.",
+		Attributes: map[string]schema.Attribute{
+			TimeoutCreate: timeoutAttribute(TimeoutCreate),
+			TimeoutUpdate: timeoutAttribute(TimeoutUpdate),
+			TimeoutDelete: timeoutAttribute(TimeoutDelete),
+		},
+	}
+}
+
+// Timeout returns the timeout configured for the given operation in the timeouts block.
+// If no timeout is configured, zero is returned.
+func Timeout(timeouts types.Object, operation string) (time.Duration, error) {
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return 0, nil
+	}
+	value, ok := timeouts.Attributes()[operation].(types.String)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("parsing %s timeout: %w", operation, err)
+	}
+	return timeout, nil
+}
+
+// WithTimeout sets the given timeout on the wait handler, e.g. the timeout returned by Timeout.
+// If the timeout is zero, the handler keeps its default timeout.
+func WithTimeout[T any](handler *wait.AsyncActionHandler[T], timeout time.Duration) *wait.AsyncActionHandler[T] {
+	if timeout > 0 {
+		handler.SetTimeout(timeout)
+	}
+	return handler
+}
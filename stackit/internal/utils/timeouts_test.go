@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		description string
+		timeouts    types.Object
+		operation   string
+		expected    time.Duration
+		isValid     bool
+	}{
+		{
+			"null_timeouts",
+			types.ObjectNull(TimeoutsTypes),
+			TimeoutCreate,
+			0,
+			true,
+		},
+		{
+			"configured_timeout",
+			types.ObjectValueMust(TimeoutsTypes, map[string]attr.Value{
+				TimeoutCreate: types.StringValue("90m"),
+				TimeoutUpdate: types.StringNull(),
+				TimeoutDelete: types.StringValue("1h30m"),
+			}),
+			TimeoutDelete,
+			90 * time.Minute,
+			true,
+		},
+		{
+			"operation_not_configured",
+			types.ObjectValueMust(TimeoutsTypes, map[string]attr.Value{
+				TimeoutCreate: types.StringValue("90m"),
+				TimeoutUpdate: types.StringNull(),
+				TimeoutDelete: types.StringNull(),
+			}),
+			TimeoutUpdate,
+			0,
+			true,
+		},
+		{
+			"invalid_duration",
+			types.ObjectValueMust(TimeoutsTypes, map[string]attr.Value{
+				TimeoutCreate: types.StringValue("forever"),
+				TimeoutUpdate: types.StringNull(),
+				TimeoutDelete: types.StringNull(),
+			}),
+			TimeoutCreate,
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := Timeout(tt.timeouts, tt.operation)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid && output != tt.expected {
+				t.Fatalf("Timeout does not match: expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
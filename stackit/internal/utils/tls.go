@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+// toTLSConfig returns the TLS settings of the provider block. The certificates in caCertPem are trusted in addition
+// to the system certificates, e.g. for custom endpoints behind a gateway with a private CA.
+func toTLSConfig(caCertPem string, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caCertPem != "" {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM([]byte(caCertPem)) {
+			return nil, fmt.Errorf("no valid PEM encoded certificate found in CA bundle")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	tlsConfig.InsecureSkipVerify = insecureSkipVerify //nolint:gosec // explicitly requested in the provider block
+	return tlsConfig, nil
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// TransportConfig holds the settings of the provider block for the connections to the APIs.
+type TransportConfig struct {
+	ProxyUrl           string
+	CACertPEM          string
+	InsecureSkipVerify bool
+}
+
+// transports caches the transports by their settings, so that provider instances with the same settings
+// (e.g. aliases) share their connections.
+var (
+	transports      = map[TransportConfig]*http.Transport{}
+	transportsMutex sync.Mutex
+)
+
+// NewTransport returns the transport for all requests of a provider instance with the given settings.
+// It is a copy of http.DefaultTransport, which is never modified, so that the settings of a provider block
+// don't apply to the other provider blocks (e.g. aliases) or to other HTTP clients of the process.
+func NewTransport(cfg TransportConfig) (*http.Transport, error) {
+	transportsMutex.Lock()
+	defer transportsMutex.Unlock()
+	if transport, ok := transports[cfg]; ok {
+		return transport, nil
+	}
+
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("default transport has unexpected type %T", http.DefaultTransport)
+	}
+	transport := defaultTransport.Clone()
+	if cfg.ProxyUrl != "" {
+		proxyUrl, err := ParseProxyUrl(cfg.ProxyUrl)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+	if cfg.CACertPEM != "" || cfg.InsecureSkipVerify {
+		tlsConfig, err := toTLSConfig(cfg.CACertPEM, cfg.InsecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	transports[cfg] = transport
+	return transport, nil
+}
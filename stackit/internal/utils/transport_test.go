@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	serverCaCertPem := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}))
+
+	tests := []struct {
+		description     string
+		cfg             TransportConfig
+		isValid         bool
+		requestSucceeds bool
+	}{
+		{
+			"no_settings",
+			TransportConfig{},
+			true,
+			false,
+		},
+		{
+			"ca_cert",
+			TransportConfig{CACertPEM: serverCaCertPem},
+			true,
+			true,
+		},
+		{
+			"insecure_skip_verify",
+			TransportConfig{InsecureSkipVerify: true},
+			true,
+			true,
+		},
+		{
+			"invalid_ca_cert",
+			TransportConfig{CACertPEM: "not a certificate"},
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			transport, err := NewTransport(tt.cfg)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !tt.isValid {
+				return
+			}
+
+			client := &http.Client{Transport: transport}
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if tt.requestSucceeds && err != nil {
+				t.Fatalf("Request should have succeeded: %v", err)
+			}
+			if !tt.requestSucceeds && err == nil {
+				t.Fatalf("Request should have failed")
+			}
+
+			// The settings must not leak into the default transport used by other clients
+			resp, err = http.DefaultClient.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+				t.Fatalf("Request with the default transport should have failed")
+			}
+		})
+	}
+}
+
+func TestNewTransportReused(t *testing.T) {
+	first, err := NewTransport(TransportConfig{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	second, err := NewTransport(TransportConfig{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if first != second {
+		t.Fatalf("Expected the transport to be reused for the same settings")
+	}
+	other, err := NewTransport(TransportConfig{})
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if other == first {
+		t.Fatalf("Expected a new transport for different settings")
+	}
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	proxyUrl := "http://proxy.example.com:3128"
+	transport, err := NewTransport(TransportConfig{ProxyUrl: proxyUrl})
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://iaas.api.eu01.stackit.cloud", http.NoBody)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	got, err := transport.Proxy(req)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if got == nil || got.String() != proxyUrl {
+		t.Fatalf("Expected proxy %q, got %v", proxyUrl, got)
+	}
+
+	// The proxy must not leak into the default transport used by other clients
+	got, err = http.DefaultTransport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	if got != nil && got.String() == proxyUrl {
+		t.Fatalf("Expected the default transport to be unchanged")
+	}
+
+	_, err = NewTransport(TransportConfig{ProxyUrl: "ftp://proxy.example.com"})
+	if err == nil {
+		t.Fatalf("Should have failed for an unsupported scheme")
+	}
+}
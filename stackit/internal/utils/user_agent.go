@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"net/http"
+	"strings"
+)
+
+type userAgentRoundTripper struct {
+	next   http.RoundTripper
+	suffix string
+}
+
+// NewUserAgentRoundTripper returns a round tripper which appends the given suffix, e.g. "my-platform/1.2.0",
+// to the User-Agent header of each API request, so that the API traffic can be attributed to an application.
+func NewUserAgentRoundTripper(next http.RoundTripper, suffix string) http.RoundTripper {
+	return &userAgentRoundTripper{
+		next:   next,
+		suffix: suffix,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *userAgentRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The request must not be modified by a RoundTripper
+	uaReq := req.Clone(req.Context())
+	uaReq.Header.Set("User-Agent", appendUserAgent(req.Header.Get("User-Agent"), rt.suffix))
+	return rt.next.RoundTrip(uaReq)
+}
+
+// appendUserAgent appends the suffix to the user agent, separated by a space.
+func appendUserAgent(userAgent, suffix string) string {
+	return strings.TrimSpace(userAgent + " " + suffix)
+}
+
+// UserAgentProduct returns the product token of the User-Agent header for the given name and version,
+// e.g. "my-platform/1.2.0". The version is omitted if it is empty.
+func UserAgentProduct(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return name + "/" + version
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUserAgentProduct(t *testing.T) {
+	tests := []struct {
+		description string
+		name        string
+		version     string
+		expected    string
+	}{
+		{"name_and_version", "my-platform", "1.2.0", "my-platform/1.2.0"},
+		{"name_only", "my-platform", "", "my-platform"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := UserAgentProduct(tt.name, tt.version)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestUserAgentRoundTripper(t *testing.T) {
+	tests := []struct {
+		description string
+		userAgent   string
+		expected    string
+	}{
+		{"append", "stackit-sdk-go/iaas", "stackit-sdk-go/iaas my-platform/1.2.0"},
+		{"no_user_agent", "", "my-platform/1.2.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var received string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r.Header.Get("User-Agent")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			// Set the header explicitly, so that the empty user agent is not replaced by the default one of Go
+			req.Header["User-Agent"] = []string{tt.userAgent}
+
+			client := &http.Client{
+				Transport: NewUserAgentRoundTripper(http.DefaultTransport, "my-platform/1.2.0"),
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if received != tt.expected {
+				t.Fatalf("Expected user agent %q, got %q", tt.expected, received)
+			}
+			if req.Header.Get("User-Agent") != tt.userAgent {
+				t.Fatalf("Original request was modified")
+			}
+		})
+	}
+}
@@ -114,3 +114,27 @@ type value interface {
 func IsUndefined(val value) bool {
 	return val.IsUnknown() || val.IsNull()
 }
+
+// FindOneByName returns the only item of the list with the given name, which is read from each item by getName.
+// Names are not unique for most resources, so an error is returned if none or several items have the name.
+// The kind and idAttribute of the resource are used in the error messages, e.g. "volume" and "volume_id".
+func FindOneByName[T any](items *[]T, getName func(*T) *string, name, kind, idAttribute string) (*T, error) {
+	var match *T
+	if items != nil {
+		for i := range *items {
+			item := &(*items)[i]
+			itemName := getName(item)
+			if itemName == nil || *itemName != name {
+				continue
+			}
+			if match != nil {
+				return nil, fmt.Errorf("found several %ss with name %q, use `%s` to select one of them", kind, name, idAttribute)
+			}
+			match = item
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("no %s found with name %q", kind, name)
+	}
+	return match, nil
+}
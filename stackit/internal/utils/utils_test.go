@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 )
 
 func TestReconcileStrLists(t *testing.T) {
@@ -268,3 +269,72 @@ func TestIsLegacyProjectRole(t *testing.T) {
 		})
 	}
 }
+
+func TestFindOneByName(t *testing.T) {
+	type item struct {
+		Id   string
+		Name *string
+	}
+	tests := []struct {
+		description string
+		items       *[]item
+		name        string
+		expected    *item
+		isValid     bool
+	}{
+		{
+			"single_match",
+			&[]item{
+				{Id: "id-1", Name: utils.Ptr("other")},
+				{Id: "id-2", Name: utils.Ptr("name")},
+				{Id: "id-3"},
+			},
+			"name",
+			&item{Id: "id-2", Name: utils.Ptr("name")},
+			true,
+		},
+		{
+			"no_match",
+			&[]item{
+				{Id: "id-1", Name: utils.Ptr("other")},
+			},
+			"name",
+			nil,
+			false,
+		},
+		{
+			"several_matches",
+			&[]item{
+				{Id: "id-1", Name: utils.Ptr("name")},
+				{Id: "id-2", Name: utils.Ptr("name")},
+			},
+			"name",
+			nil,
+			false,
+		},
+		{
+			"no_items",
+			nil,
+			"name",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := FindOneByName(tt.items, func(i *item) *string { return i.Name }, tt.name, "item", "item_id")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+// Interval in which Wait logs the progress of an async action. It is a variable so that tests can shorten it.
+var waitProgressInterval = 30 * time.Second
+
+// Wait waits until the async action of the given handler is finished, e.g. the creation of an instance.
+// While waiting, the elapsed time is logged periodically, so that long-running actions don't look stuck.
+// The action is a short description used in the log messages, e.g. "SKE cluster creation".
+// If the context is canceled, Wait returns immediately with the context error.
+func Wait[T any](ctx context.Context, handler *wait.AsyncActionHandler[T], action string) (*T, error) {
+	type result struct {
+		resp *T
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := handler.WaitWithContext(ctx)
+		done <- result{resp, err}
+	}()
+
+	start := time.Now()
+	ticker := time.NewTicker(waitProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case r := <-done:
+			if r.err != nil && ctx.Err() != nil {
+				return r.resp, fmt.Errorf("waiting for %s: %w", action, ctx.Err())
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Finished waiting for %s after %s", action, time.Since(start).Round(time.Second)))
+			return r.resp, r.err
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for %s: %w", action, ctx.Err())
+		case <-ticker.C:
+			tflog.Info(ctx, fmt.Sprintf("Still waiting for %s, %s elapsed", action, time.Since(start).Round(time.Second)))
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+)
+
+func TestWait(t *testing.T) {
+	defaultWaitProgressInterval := waitProgressInterval
+	t.Cleanup(func() { waitProgressInterval = defaultWaitProgressInterval })
+	waitProgressInterval = time.Millisecond
+
+	checkErr := &oapierror.GenericOpenAPIError{StatusCode: 400}
+
+	tests := []struct {
+		description     string
+		finishOnAttempt int
+		checkErr        error
+		cancelContext   bool
+		isValid         bool
+	}{
+		{
+			"finished_immediately",
+			1,
+			nil,
+			false,
+			true,
+		},
+		{
+			"finished_after_several_checks",
+			3,
+			nil,
+			false,
+			true,
+		},
+		{
+			"check_error",
+			0,
+			checkErr,
+			false,
+			false,
+		},
+		{
+			"context_canceled",
+			0,
+			nil,
+			true,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelContext {
+				cancel()
+			}
+
+			response := "done"
+			attempts := 0
+			handler := wait.New(func() (bool, *string, error) {
+				attempts++
+				if tt.checkErr != nil {
+					return false, nil, tt.checkErr
+				}
+				if attempts == tt.finishOnAttempt {
+					return true, &response, nil
+				}
+				return false, nil, nil
+			}).SetThrottle(time.Millisecond)
+
+			resp, err := Wait(ctx, handler, "test action")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.cancelContext && !errors.Is(err, context.Canceled) {
+				t.Fatalf("Expected context cancellation error, got: %v", err)
+			}
+			if tt.checkErr != nil && !errors.Is(err, tt.checkErr) {
+				t.Fatalf("Expected check error, got: %v", err)
+			}
+			if tt.isValid && (resp == nil || *resp != response) {
+				t.Fatalf("Expected response %q, got: %s", response, fmt.Sprint(resp))
+			}
+		})
+	}
+}
@@ -12,7 +12,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
@@ -23,6 +25,8 @@ import (
 const (
 	MajorMinorVersionRegex = `^\d+\.\d+?$`
 	FullVersionRegex       = `^\d+\.\d+.\d+?$`
+	LabelKeyRegex          = `^[a-z]((-|_|[a-z0-9])){0,62}$`
+	LabelValueRegex        = `^(-|_|[a-z0-9]){0,63}$`
 )
 
 type Validator struct {
@@ -50,6 +54,36 @@ func (v *Validator) ValidateString(ctx context.Context, req validator.StringRequ
 	v.validate(ctx, req, resp)
 }
 
+// ResourceValidator validates constraints spanning multiple attributes of a resource configuration.
+type ResourceValidator struct {
+	description string
+	validate    ResourceValidationFn
+}
+
+type ResourceValidationFn func(context.Context, resource.ValidateConfigRequest, *resource.ValidateConfigResponse)
+
+var _ = resource.ConfigValidator(&ResourceValidator{})
+
+// NewResourceValidator returns a resource level validator running the given validation function.
+func NewResourceValidator(description string, validate ResourceValidationFn) *ResourceValidator {
+	return &ResourceValidator{
+		description: description,
+		validate:    validate,
+	}
+}
+
+func (v *ResourceValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v *ResourceValidator) MarkdownDescription(_ context.Context) string {
+	return v.description
+}
+
+func (v *ResourceValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) { // nolint:gocritic // function signature required by Terraform
+	v.validate(ctx, req, resp)
+}
+
 func UUID() *Validator {
 	description := "value must be an UUID"
 
@@ -205,6 +239,53 @@ func VersionNumber() *Validator {
 	}
 }
 
+// LabelKey validates a label key against the syntax accepted by the IaaS API.
+func LabelKey() *Validator {
+	description := fmt.Sprintf("label key must match the regex %s", LabelKeyRegex)
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			r := regexp.MustCompile(LabelKeyRegex)
+			if !r.MatchString(req.ConfigValue.ValueString()) {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
+// LabelValue validates a label value against the syntax accepted by the IaaS API.
+func LabelValue() *Validator {
+	description := fmt.Sprintf("label value must match the regex %s", LabelValueRegex)
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			r := regexp.MustCompile(LabelValueRegex)
+			if !r.MatchString(req.ConfigValue.ValueString()) {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
+// Labels returns the validators for a labels map attribute, checking every key and value
+// against the syntax accepted by the IaaS API.
+func Labels() []validator.Map {
+	return []validator.Map{
+		mapvalidator.KeysAre(LabelKey()),
+		mapvalidator.ValueStringsAre(LabelValue()),
+	}
+}
+
 func RFC3339SecondsOnly() *Validator {
 	description := "value must be in RFC339 format (seconds only)"
 
@@ -251,6 +332,27 @@ func CIDR() *Validator {
 	}
 }
 
+func CIDRList() *Validator {
+	description := "value must be a comma-separated list of prefixes in CIDR notation"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			for _, cidr := range strings.Split(req.ConfigValue.ValueString(), ",") {
+				_, _, err := net.ParseCIDR(strings.TrimSpace(cidr))
+				if err != nil {
+					resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+						req.Path,
+						fmt.Sprintf("parsing value in CIDR notation: invalid CIDR address %q", strings.TrimSpace(cidr)),
+						req.ConfigValue.ValueString(),
+					))
+					return
+				}
+			}
+		},
+	}
+}
+
 func Rrule() *Validator {
 	description := "value must be in a valid RRULE format"
 
@@ -295,3 +397,34 @@ func FileExists() *Validator {
 		},
 	}
 }
+
+// IPWithinPrefix returns a resource validator that checks, if the IP address in ipAttribute
+// is part of the CIDR prefix in prefixAttribute. The check is skipped if one of them is not known yet.
+func IPWithinPrefix(ipAttribute, prefixAttribute string) *ResourceValidator {
+	description := fmt.Sprintf("%q must be part of %q", ipAttribute, prefixAttribute)
+
+	return NewResourceValidator(description, func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+		var ipValue, prefixValue basetypes.StringValue
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(ipAttribute), &ipValue)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(prefixAttribute), &prefixValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if utils.IsUndefined(ipValue) || utils.IsUndefined(prefixValue) {
+			return
+		}
+
+		ip := net.ParseIP(ipValue.ValueString())
+		_, prefix, err := net.ParseCIDR(prefixValue.ValueString())
+		if ip == nil || err != nil {
+			// Malformed values are reported by the attribute validators
+			return
+		}
+		if !prefix.Contains(ip) {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+				path.Root(ipAttribute),
+				fmt.Sprintf("%q (%s) is not part of %q (%s)", ipAttribute, ipValue.ValueString(), prefixAttribute, prefixValue.ValueString()),
+			))
+		}
+	})
+}
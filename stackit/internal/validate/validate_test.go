@@ -2,8 +2,10 @@ package validate
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -539,6 +541,119 @@ func TestVersionNumber(t *testing.T) {
 	}
 }
 
+func TestLabelKey(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"env",
+			true,
+		},
+		{
+			"ok-separators",
+			"my-label_1",
+			true,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
+		{
+			"not ok-leading digit",
+			"1label",
+			false,
+		},
+		{
+			"not ok-uppercase",
+			"Env",
+			false,
+		},
+		{
+			"not ok-domain",
+			"ske.stackit.cloud/cluster",
+			false,
+		},
+		{
+			"not ok-too long",
+			strings.Repeat("a", 64),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			LabelKey().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestLabelValue(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"ok",
+			"prod",
+			true,
+		},
+		{
+			"ok-leading digit",
+			"1-a_b",
+			true,
+		},
+		{
+			"ok-empty",
+			"",
+			true,
+		},
+		{
+			"not ok-uppercase",
+			"Prod",
+			false,
+		},
+		{
+			"not ok-dot",
+			"v1.2",
+			false,
+		},
+		{
+			"not ok-too long",
+			strings.Repeat("a", 64),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			LabelValue().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestRFC3339SecondsOnly(t *testing.T) {
 	tests := []struct {
 		description string
@@ -677,6 +792,60 @@ func TestCIDR(t *testing.T) {
 	}
 }
 
+func TestCIDRList(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"single_block",
+			"198.51.100.14/24",
+			true,
+		},
+		{
+			"IPv4_and_IPv6",
+			"0.0.0.0/0,::/0",
+			true,
+		},
+		{
+			"with_spaces",
+			"198.51.100.0/24, 2001:db8::/48",
+			true,
+		},
+		{
+			"no_block",
+			"198.51.100.0/24,111.222.111.222",
+			false,
+		},
+		{
+			"trailing_comma",
+			"198.51.100.0/24,",
+			false,
+		},
+		{
+			"empty",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			CIDRList().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestRrule(t *testing.T) {
 	tests := []struct {
 		description string
@@ -769,3 +938,83 @@ func TestFileExists(t *testing.T) {
 		})
 	}
 }
+
+func TestIPWithinPrefix(t *testing.T) {
+	tests := []struct {
+		description string
+		ip          tftypes.Value
+		prefix      tftypes.Value
+		isValid     bool
+	}{
+		{
+			"ok",
+			tftypes.NewValue(tftypes.String, "10.1.2.1"),
+			tftypes.NewValue(tftypes.String, "10.1.2.0/24"),
+			true,
+		},
+		{
+			"ok IPv6",
+			tftypes.NewValue(tftypes.String, "2001:db8::1"),
+			tftypes.NewValue(tftypes.String, "2001:db8::/64"),
+			true,
+		},
+		{
+			"ip outside of prefix",
+			tftypes.NewValue(tftypes.String, "10.1.3.1"),
+			tftypes.NewValue(tftypes.String, "10.1.2.0/24"),
+			false,
+		},
+		{
+			"null ip",
+			tftypes.NewValue(tftypes.String, nil),
+			tftypes.NewValue(tftypes.String, "10.1.2.0/24"),
+			true,
+		},
+		{
+			"unknown prefix",
+			tftypes.NewValue(tftypes.String, "10.1.3.1"),
+			tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			true,
+		},
+		{
+			"malformed prefix",
+			tftypes.NewValue(tftypes.String, "10.1.3.1"),
+			tftypes.NewValue(tftypes.String, "not-a-prefix"),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := resource.ValidateConfigResponse{}
+			scheme := tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"gateway": tftypes.String,
+					"prefix":  tftypes.String,
+				},
+			}
+			value := map[string]tftypes.Value{
+				"gateway": tt.ip,
+				"prefix":  tt.prefix,
+			}
+
+			IPWithinPrefix("gateway", "prefix").ValidateResource(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"gateway": schema.StringAttribute{},
+							"prefix":  schema.StringAttribute{},
+						},
+					},
+					Raw: tftypes.NewValue(scheme, value),
+				},
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
@@ -3,15 +3,25 @@ package stackit
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	argusCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/argus/credential"
 	argusInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/argus/instance"
 	argusScrapeConfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/argus/scrapeconfig"
+	authorizationCallerPermissions "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/authorization/callerpermissions"
+	authorizationProjectIAM "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/authorization/projectiam"
 	dnsRecordSet "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/recordset"
 	dnsZone "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/zone"
 	iaasAffinityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/affinitygroup"
@@ -43,6 +53,7 @@ import (
 	objectStorageBucket "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/bucket"
 	objecStorageCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/credential"
 	objecStorageCredentialsGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/credentialsgroup"
+	objectStoragePresignedURL "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/presignedurl"
 	observabilityCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/credential"
 	observabilityInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/instance"
 	observabilityScrapeConfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/scrapeconfig"
@@ -66,15 +77,19 @@ import (
 	sqlServerFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/instance"
 	sqlServerFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/user"
 
-	sdkauth "github.com/stackitcloud/stackit-sdk-go/core/auth"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/functions"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &Provider{}
+	_ provider.Provider                       = &Provider{}
+	_ provider.ProviderWithEphemeralResources = &Provider{}
+	_ provider.ProviderWithFunctions          = &Provider{}
 )
 
 // Provider is the provider implementation.
@@ -98,13 +113,20 @@ func (p *Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp
 
 type providerModel struct {
 	CredentialsFilePath             types.String `tfsdk:"credentials_path"`
-	ServiceAccountEmail             types.String `tfsdk:"service_account_email"` // Deprecated: ServiceAccountEmail is not required and will be removed after 12th June 2025
+	ServiceAccountEmail             types.String `tfsdk:"service_account_email"`
 	ServiceAccountKey               types.String `tfsdk:"service_account_key"`
 	ServiceAccountKeyPath           types.String `tfsdk:"service_account_key_path"`
 	PrivateKey                      types.String `tfsdk:"private_key"`
 	PrivateKeyPath                  types.String `tfsdk:"private_key_path"`
 	Token                           types.String `tfsdk:"service_account_token"`
+	UseOIDC                         types.Bool   `tfsdk:"use_oidc"`
+	OIDCToken                       types.String `tfsdk:"oidc_token"`
+	OIDCTokenPath                   types.String `tfsdk:"oidc_token_path"`
+	OIDCAudience                    types.String `tfsdk:"oidc_audience"`
 	Region                          types.String `tfsdk:"region"`
+	DefaultProjectId                types.String `tfsdk:"default_project_id"`
+	DefaultLabels                   types.Map    `tfsdk:"default_labels"`
+	IgnoreLabels                    types.List   `tfsdk:"ignore_labels"`
 	ArgusCustomEndpoint             types.String `tfsdk:"argus_custom_endpoint"`
 	DNSCustomEndpoint               types.String `tfsdk:"dns_custom_endpoint"`
 	IaaSCustomEndpoint              types.String `tfsdk:"iaas_custom_endpoint"`
@@ -127,7 +149,24 @@ type providerModel struct {
 	ResourceManagerCustomEndpoint   types.String `tfsdk:"resourcemanager_custom_endpoint"`
 	TokenCustomEndpoint             types.String `tfsdk:"token_custom_endpoint"`
 	EnableBetaResources             types.Bool   `tfsdk:"enable_beta_resources"`
+	EnablePlanTimeChecks            types.Bool   `tfsdk:"enable_plan_time_checks"`
+	EnableProjectValidation         types.Bool   `tfsdk:"enable_project_validation"`
+	EnableSensitiveAttributesAudit  types.Bool   `tfsdk:"enable_sensitive_attributes_audit"`
+	EnableAPILogging                types.Bool   `tfsdk:"enable_api_logging"`
 	ServiceEnablementCustomEndpoint types.String `tfsdk:"service_enablement_custom_endpoint"`
+	Retry                           types.Object `tfsdk:"retry"`
+	ProxyUrl                        types.String `tfsdk:"proxy_url"`
+	CACertPEM                       types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify              types.Bool   `tfsdk:"insecure_skip_verify"`
+	AppName                         types.String `tfsdk:"app_name"`
+	AppVersion                      types.String `tfsdk:"app_version"`
+}
+
+// Struct corresponding to providerModel.Retry
+type retryModel struct {
+	MaxAttempts          types.Int64  `tfsdk:"max_attempts"`
+	MaxBackoff           types.String `tfsdk:"max_backoff"`
+	RetryableStatusCodes types.List   `tfsdk:"retryable_status_codes"`
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -136,11 +175,18 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 		"credentials_path":                   "Path of JSON from where the credentials are read. Takes precedence over the env var `STACKIT_CREDENTIALS_PATH`. Default value is `~/.stackit/credentials.json`.",
 		"service_account_token":              "Token used for authentication. If set, the token flow will be used to authenticate all operations.",
 		"service_account_key_path":           "Path for the service account key used for authentication. If set, the key flow will be used to authenticate all operations.",
-		"service_account_key":                "Service account key used for authentication. If set, the key flow will be used to authenticate all operations.",
+		"service_account_key":                "Service account key used for authentication. If set, the key flow will be used to authenticate all operations. Can also be set using the environment variable `STACKIT_SERVICE_ACCOUNT_KEY`.",
 		"private_key_path":                   "Path for the private RSA key used for authentication, relevant for the key flow. It takes precedence over the private key that is included in the service account key.",
-		"private_key":                        "Private RSA key used for authentication, relevant for the key flow. It takes precedence over the private key that is included in the service account key.",
-		"service_account_email":              "Service account email. It can also be set using the environment variable STACKIT_SERVICE_ACCOUNT_EMAIL. It is required if you want to use the resource manager project resource.",
+		"private_key":                        "Private RSA key used for authentication, relevant for the key flow. It takes precedence over the private key that is included in the service account key. Can also be set using the environment variable `STACKIT_PRIVATE_KEY`.",
+		"service_account_email":              "Service account email. It can also be set using the environment variable STACKIT_SERVICE_ACCOUNT_EMAIL. It is required if `use_oidc` is set and deprecated otherwise, since the other authentication flows don't need it.",
+		"use_oidc":                           "Authenticate with workload identity federation: an OIDC token issued by the CI system (e.g. GitHub Actions or GitLab CI) is exchanged for access tokens of the service account `service_account_email`, so that no service account key has to be distributed. The service account must trust the issuer of the token. Can also be set using the environment variable `STACKIT_USE_OIDC`. Default is false.",
+		"oidc_token":                         "OIDC token used for the workload identity federation, e.g. a GitLab CI ID token. Can also be set using the environment variable `STACKIT_OIDC_TOKEN`. If neither the token nor `oidc_token_path` is set, the token is requested from GitHub Actions, which requires the permission `id-token: write`.",
+		"oidc_token_path":                    "Path of a file containing the OIDC token used for the workload identity federation. The file is read whenever a new access token is needed. Can also be set using the environment variable `STACKIT_FEDERATED_TOKEN_FILE`.",
+		"oidc_audience":                      "Audience of the OIDC token requested from GitHub Actions. Only relevant if the token is requested from GitHub Actions. If not set, the default audience of GitHub is used.",
 		"region":                             "Region will be used as the default location for regional services. Not all services require a region, some are global",
+		"default_project_id":                 "Project ID used by resources that support it (currently the Postgres Flex resources) when their `project_id` is not set.",
+		"ignore_labels":                      "Label key prefixes of labels which are managed outside of Terraform, e.g. by platform automation or cost tooling. Labels returned by the API whose key starts with one of the prefixes are not shown in the `labels` attribute of the resources, unless they are also set there, and are kept on update. Currently applies to the IaaS resources, e.g. servers, networks and volumes.",
+		"default_labels":                     "Labels which are added to all resources that support labels (currently the IaaS resources, e.g. servers, networks and volumes, and the Resource Manager project). Labels set on a resource take precedence. Default labels are included in the `labels` attribute of the resources, so adding or changing them updates the resources.",
 		"argus_custom_endpoint":              "Custom endpoint for the Argus service",
 		"dns_custom_endpoint":                "Custom endpoint for the DNS service",
 		"iaas_custom_endpoint":               "Custom endpoint for the IaaS service",
@@ -162,8 +208,21 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 		"sqlserverflex_custom_endpoint":      "Custom endpoint for the SQL Server Flex service",
 		"ske_custom_endpoint":                "Custom endpoint for the Kubernetes Engine (SKE) service",
 		"service_enablement_custom_endpoint": "Custom endpoint for the Service Enablement API",
-		"token_custom_endpoint":              "Custom endpoint for the token API, which is used to request access tokens when using the key flow",
-		"enable_beta_resources":              "Enable beta resources. Default is false.",
+		"token_custom_endpoint":              "Custom endpoint for the token API, which is used to request access tokens when using the key flow or the workload identity federation",
+		"enable_api_logging":                 "Enable the logging of all API requests and responses, including their headers and bodies, on debug level (e.g. with `TF_LOG=DEBUG`). Passwords, tokens, keys and other sensitive values are redacted. Default is false.",
+		"enable_beta_resources":              "Enable beta resources. Can also be set using the environment variable `STACKIT_TF_ENABLE_BETA_RESOURCES`, which takes precedence. Default is false.",
+		"enable_sensitive_attributes_audit":  "Enable the sensitive attributes audit. If set, a warning listing the sensitive attributes (e.g. passwords, keys or kubeconfigs) which will be persisted to the Terraform state is emitted when a resource is created or one of its sensitive attributes is changed. Default is false.",
+		"enable_plan_time_checks":            "Enable plan-time checks, which query the API while planning the creation of some resources (e.g. DNS record sets, Object Storage buckets and Postgres Flex databases) and warn if a conflicting object already exists. Default is false.",
+		"enable_project_validation":          "Enable the validation of the project ID while planning the creation of the resources which create the first objects of a service in a project (e.g. DNS zones, IaaS networks, servers and volumes, SKE clusters, Load Balancers, Object Storage buckets and the instances of the database, messaging and observability services). The project is requested from the Resource Manager API, and an error is reported if it doesn't exist, can't be accessed or isn't active. Default is false.",
+		"proxy_url":                          "URL of an HTTP(S) or SOCKS5 proxy through which all API requests are sent, e.g. `http://proxy.example.com:3128`. If not set, the proxy is read from the environment variables `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY`.",
+		"ca_cert_pem":                        "PEM encoded CA certificates which are trusted in addition to the system certificates for all API requests, e.g. when a custom endpoint points at a gateway with a private CA.",
+		"insecure_skip_verify":               "Skip the verification of the TLS certificates of all API requests. This is insecure and should only be used for testing. Default is false.",
+		"app_name":                           "Name of the application using the provider, e.g. a platform or partner automation. It is appended to the User-Agent header of all API requests, so that the API traffic can be attributed to the application. Must not contain whitespace or `/`.",
+		"app_version":                        "Version of the application using the provider, appended to the User-Agent header together with `app_name`. Requires `app_name` to be set.",
+		"retry":                              "Retry policy for the API requests of all services. If not set, only rate limited requests (HTTP `429`) are retried, with the default number of attempts and backoff. A `Retry-After` header sent by the API is respected, unless it exceeds `max_backoff`.",
+		"retry_max_attempts":                 fmt.Sprintf("Maximum number of attempts per request, including the first one. Defaults to `%d`.", utils.DefaultRetryMaxAttempts),
+		"retry_max_backoff":                  fmt.Sprintf("Maximum time to wait between two attempts, e.g. `10s`. The wait time grows exponentially with jitter, starting at 1 second. Defaults to `%s`.", utils.DefaultRetryMaxBackoff),
+		"retry_retryable_status_codes":       fmt.Sprintf("HTTP status codes which are retried. Requests which are not idempotent (e.g. creating a resource) are only retried on `429`. Defaults to %s.", strings.Join(utils.QuoteValues(statusCodesToStrings(utils.DefaultRetryableStatusCodes)), ", ")),
 	}
 
 	resp.Schema = schema.Schema{
@@ -173,9 +232,8 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Description: descriptions["credentials_path"],
 			},
 			"service_account_email": schema.StringAttribute{
-				Optional:           true,
-				Description:        descriptions["service_account_email"],
-				DeprecationMessage: "The `service_account_email` field has been deprecated because it is not required. Will be removed after June 12th 2025.",
+				Optional:    true,
+				Description: descriptions["service_account_email"],
 			},
 			"service_account_token": schema.StringAttribute{
 				Optional:    true,
@@ -187,20 +245,54 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			},
 			"service_account_key": schema.StringAttribute{
 				Optional:    true,
+				Sensitive:   true,
 				Description: descriptions["service_account_key"],
 			},
 			"private_key": schema.StringAttribute{
 				Optional:    true,
+				Sensitive:   true,
 				Description: descriptions["private_key"],
 			},
 			"private_key_path": schema.StringAttribute{
 				Optional:    true,
 				Description: descriptions["private_key_path"],
 			},
+			"use_oidc": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["use_oidc"],
+			},
+			"oidc_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["oidc_token"],
+			},
+			"oidc_token_path": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["oidc_token_path"],
+			},
+			"oidc_audience": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["oidc_audience"],
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: descriptions["region"],
 			},
+			"default_project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["default_project_id"],
+			},
+			"default_labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: descriptions["default_labels"],
+				Validators:  validate.Labels(),
+			},
+			"ignore_labels": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: descriptions["ignore_labels"],
+			},
 			"argus_custom_endpoint": schema.StringAttribute{
 				Optional:           true,
 				Description:        descriptions["argus_custom_endpoint"],
@@ -294,6 +386,61 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:    true,
 				Description: descriptions["enable_beta_resources"],
 			},
+			"enable_plan_time_checks": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["enable_plan_time_checks"],
+			},
+			"enable_project_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["enable_project_validation"],
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["proxy_url"],
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["ca_cert_pem"],
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["insecure_skip_verify"],
+			},
+			"app_name": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["app_name"],
+			},
+			"app_version": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["app_version"],
+			},
+			"retry": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: descriptions["retry"],
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Optional:    true,
+						Description: descriptions["retry_max_attempts"],
+					},
+					"max_backoff": schema.StringAttribute{
+						Optional:    true,
+						Description: descriptions["retry_max_backoff"],
+					},
+					"retryable_status_codes": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Description: descriptions["retry_retryable_status_codes"],
+					},
+				},
+			},
+			"enable_sensitive_attributes_audit": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["enable_sensitive_attributes_audit"],
+			},
+			"enable_api_logging": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["enable_api_logging"],
+			},
 		},
 	}
 }
@@ -329,9 +476,38 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	if !(providerConfig.Token.IsUnknown() || providerConfig.Token.IsNull()) {
 		sdkConfig.Token = providerConfig.Token.ValueString()
 	}
+	// The SDK only reads the key paths from the environment, the key contents are read here
+	// so that no key file has to be written to disk (e.g. in CI runners)
+	if sdkConfig.ServiceAccountKey == "" && sdkConfig.ServiceAccountKeyPath == "" {
+		sdkConfig.ServiceAccountKey = os.Getenv("STACKIT_SERVICE_ACCOUNT_KEY")
+	}
+	if sdkConfig.PrivateKey == "" && sdkConfig.PrivateKeyPath == "" {
+		sdkConfig.PrivateKey = os.Getenv("STACKIT_PRIVATE_KEY")
+	}
 	if !(providerConfig.Region.IsUnknown() || providerConfig.Region.IsNull()) {
 		providerData.Region = providerConfig.Region.ValueString()
 	}
+	if !(providerConfig.DefaultProjectId.IsUnknown() || providerConfig.DefaultProjectId.IsNull()) {
+		providerData.DefaultProjectId = providerConfig.DefaultProjectId.ValueString()
+	}
+	if !(providerConfig.DefaultLabels.IsUnknown() || providerConfig.DefaultLabels.IsNull()) {
+		defaultLabels := map[string]string{}
+		diags = providerConfig.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		providerData.DefaultLabels = defaultLabels
+	}
+	if !(providerConfig.IgnoreLabels.IsUnknown() || providerConfig.IgnoreLabels.IsNull()) {
+		ignoreLabels := []string{}
+		diags = providerConfig.IgnoreLabels.ElementsAs(ctx, &ignoreLabels, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		providerData.IgnoreLabels = ignoreLabels
+	}
 	if !(providerConfig.ArgusCustomEndpoint.IsUnknown() || providerConfig.ArgusCustomEndpoint.IsNull()) {
 		providerData.ArgusCustomEndpoint = providerConfig.ArgusCustomEndpoint.ValueString()
 	}
@@ -395,17 +571,81 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	if !(providerConfig.EnableBetaResources.IsUnknown() || providerConfig.EnableBetaResources.IsNull()) {
 		providerData.EnableBetaResources = providerConfig.EnableBetaResources.ValueBool()
 	}
-	roundTripper, err := sdkauth.SetupAuth(sdkConfig)
+	if !(providerConfig.EnablePlanTimeChecks.IsUnknown() || providerConfig.EnablePlanTimeChecks.IsNull()) {
+		providerData.EnablePlanTimeChecks = providerConfig.EnablePlanTimeChecks.ValueBool()
+	}
+	if !(providerConfig.EnableProjectValidation.IsUnknown() || providerConfig.EnableProjectValidation.IsNull()) {
+		providerData.EnableProjectValidation = providerConfig.EnableProjectValidation.ValueBool()
+	}
+	if !(providerConfig.EnableSensitiveAttributesAudit.IsUnknown() || providerConfig.EnableSensitiveAttributesAudit.IsNull()) {
+		providerData.EnableSensitiveAttributesAudit = providerConfig.EnableSensitiveAttributesAudit.ValueBool()
+	}
+	// The proxy and the TLS settings apply to all requests of the provider instance, including the ones for access tokens
+	transportConfig := utils.TransportConfig{}
+	if !(providerConfig.ProxyUrl.IsUnknown() || providerConfig.ProxyUrl.IsNull()) {
+		transportConfig.ProxyUrl = providerConfig.ProxyUrl.ValueString()
+	}
+	if !(providerConfig.CACertPEM.IsUnknown() || providerConfig.CACertPEM.IsNull()) {
+		transportConfig.CACertPEM = providerConfig.CACertPEM.ValueString()
+	}
+	if !(providerConfig.InsecureSkipVerify.IsUnknown() || providerConfig.InsecureSkipVerify.IsNull()) {
+		transportConfig.InsecureSkipVerify = providerConfig.InsecureSkipVerify.ValueBool()
+	}
+	transport, err := utils.NewTransport(transportConfig)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Configuring transport: %v", err))
+		return
+	}
+	if transportConfig.InsecureSkipVerify {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Insecure TLS configuration", "The TLS certificates of the API requests are not verified, since \"insecure_skip_verify\" is set in the provider block. Don't use this setting in production.")
+	}
+	// The service account email is only needed by the workload identity federation, so the former deprecation still applies to the other flows
+	if !useOIDC(&providerConfig) && !(providerConfig.ServiceAccountEmail.IsUnknown() || providerConfig.ServiceAccountEmail.IsNull()) {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Deprecated attribute", "The `service_account_email` field is not required unless `use_oidc` is set and will be ignored. It is deprecated for the other authentication flows and can be removed from the provider block.")
+	}
+	var roundTripper http.RoundTripper
+	if useOIDC(&providerConfig) {
+		roundTripper, err = core.NewOIDCRoundTripper(toOIDCConfig(&providerConfig, sdkConfig.TokenCustomUrl), transport)
+	} else {
+		roundTripper, err = core.SetupAuth(sdkConfig, transport)
+	}
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up authentication: %v", err))
 		return
 	}
+	providerData.CallerEmail = core.CallerEmail(roundTripper)
+	// The logging is wrapped by the retry policy, so that every attempt is logged
+	if !(providerConfig.EnableAPILogging.IsUnknown() || providerConfig.EnableAPILogging.IsNull()) && providerConfig.EnableAPILogging.ValueBool() {
+		roundTripper = utils.NewLoggingRoundTripper(roundTripper)
+	}
+	if !(providerConfig.Retry.IsUnknown() || providerConfig.Retry.IsNull()) {
+		roundTripper, err = toRetryRoundTripper(ctx, roundTripper, providerConfig.Retry)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Configuring retry policy: %v", err))
+			return
+		}
+	} else {
+		// Rate limited requests are always retried, the other status codes only if configured
+		roundTripper = utils.NewRetryRoundTripper(roundTripper, utils.DefaultRetryMaxAttempts, utils.DefaultRetryMaxBackoff, []int{http.StatusTooManyRequests})
+	}
+	userAgentProduct, err := toUserAgentProduct(&providerConfig)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Configuring user agent: %v", err))
+		return
+	}
+	if userAgentProduct != "" {
+		roundTripper = utils.NewUserAgentRoundTripper(roundTripper, userAgentProduct)
+	}
 
-	// Make round tripper and custom endpoints available during DataSource and Resource
-	// type Configure methods.
+	// Make round tripper, caches and custom endpoints available during DataSource, Resource
+	// and EphemeralResource type Configure methods.
 	providerData.RoundTripper = roundTripper
+	providerData.Transport = transport
+	providerData.ClientCache = core.NewClientCache()
+	providerData.ResponseCache = core.NewResponseCache()
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -413,19 +653,24 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 	return []func() datasource.DataSource{
 		argusInstance.NewInstanceDataSource,
 		argusScrapeConfig.NewScrapeConfigDataSource,
+		authorizationCallerPermissions.NewCallerPermissionsDataSource,
 		dnsZone.NewZoneDataSource,
+		dnsZone.NewZonesDataSource,
 		dnsRecordSet.NewRecordSetDataSource,
 		iaasAffinityGroup.NewAffinityGroupDatasource,
 		iaasImage.NewImageDataSource,
 		iaasNetwork.NewNetworkDataSource,
+		iaasNetwork.NewNetworksDataSource,
 		iaasNetworkArea.NewNetworkAreaDataSource,
 		iaasNetworkAreaRoute.NewNetworkAreaRouteDataSource,
 		iaasNetworkInterface.NewNetworkInterfaceDataSource,
 		iaasVolume.NewVolumeDataSource,
+		iaasVolume.NewVolumesDataSource,
 		iaasPublicIp.NewPublicIpDataSource,
 		iaasPublicIpRanges.NewPublicIpRangesDataSource,
 		iaasKeyPair.NewKeyPairDataSource,
 		iaasServer.NewServerDataSource,
+		iaasServer.NewServersDataSource,
 		iaasSecurityGroup.NewSecurityGroupDataSource,
 		iaasSecurityGroupRule.NewSecurityGroupRuleDataSource,
 		loadBalancer.NewLoadBalancerDataSource,
@@ -436,15 +681,19 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		mongoDBFlexInstance.NewInstanceDataSource,
 		mongoDBFlexUser.NewUserDataSource,
 		objectStorageBucket.NewBucketDataSource,
+		objectStorageBucket.NewBucketsDataSource,
 		objecStorageCredentialsGroup.NewCredentialsGroupDataSource,
 		objecStorageCredential.NewCredentialDataSource,
+		objecStorageCredential.NewCredentialsDataSource,
 		observabilityInstance.NewInstanceDataSource,
 		observabilityScrapeConfig.NewScrapeConfigDataSource,
 		openSearchInstance.NewInstanceDataSource,
 		openSearchCredential.NewCredentialDataSource,
 		postgresFlexDatabase.NewDatabaseDataSource,
 		postgresFlexInstance.NewInstanceDataSource,
+		postgresFlexInstance.NewInstancesDataSource,
 		postgresFlexUser.NewUserDataSource,
+		postgresFlexUser.NewUsersDataSource,
 		rabbitMQInstance.NewInstanceDataSource,
 		rabbitMQCredential.NewCredentialDataSource,
 		redisInstance.NewInstanceDataSource,
@@ -460,6 +709,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		serverUpdateSchedule.NewSchedulesDataSource,
 		skeProject.NewProjectDataSource,
 		skeCluster.NewClusterDataSource,
+		skeCluster.NewClustersDataSource,
 	}
 }
 
@@ -469,6 +719,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		argusCredential.NewCredentialResource,
 		argusInstance.NewInstanceResource,
 		argusScrapeConfig.NewScrapeConfigResource,
+		authorizationProjectIAM.NewProjectIAMResource,
 		dnsZone.NewZoneResource,
 		dnsRecordSet.NewRecordSetResource,
 		iaasAffinityGroup.NewAffinityGroupResource,
@@ -502,6 +753,7 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		observabilityCredential.NewCredentialResource,
 		observabilityInstance.NewInstanceResource,
 		observabilityScrapeConfig.NewScrapeConfigResource,
+		observabilityScrapeConfig.NewScrapeConfigsResource,
 		openSearchInstance.NewInstanceResource,
 		openSearchCredential.NewCredentialResource,
 		postgresFlexDatabase.NewDatabaseResource,
@@ -523,3 +775,129 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		skeKubeconfig.NewKubeconfigResource,
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		mongoDBFlexUser.NewUserEphemeralResource,
+		objectStoragePresignedURL.NewPresignedURLEphemeralResource,
+		postgresFlexUser.NewUserEphemeralResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *Provider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewComposeIdFunction,
+		functions.NewParseIdFunction,
+	}
+}
+
+// useOIDC returns whether the workload identity federation is enabled in the provider block or the environment.
+func useOIDC(providerConfig *providerModel) bool {
+	if !(providerConfig.UseOIDC.IsUnknown() || providerConfig.UseOIDC.IsNull()) {
+		return providerConfig.UseOIDC.ValueBool()
+	}
+	useOIDC, err := strconv.ParseBool(os.Getenv("STACKIT_USE_OIDC"))
+	return err == nil && useOIDC
+}
+
+// toOIDCConfig reads the settings of the workload identity federation from the provider block, falling back to the environment.
+func toOIDCConfig(providerConfig *providerModel, tokenEndpoint string) core.OIDCConfig {
+	cfg := core.OIDCConfig{
+		ServiceAccountEmail: os.Getenv("STACKIT_SERVICE_ACCOUNT_EMAIL"),
+		Token:               os.Getenv("STACKIT_OIDC_TOKEN"),
+		TokenPath:           os.Getenv("STACKIT_FEDERATED_TOKEN_FILE"),
+		RequestURL:          os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"),
+		RequestToken:        os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"),
+		TokenEndpoint:       tokenEndpoint,
+	}
+	if !(providerConfig.ServiceAccountEmail.IsUnknown() || providerConfig.ServiceAccountEmail.IsNull()) {
+		cfg.ServiceAccountEmail = providerConfig.ServiceAccountEmail.ValueString()
+	}
+	if !(providerConfig.OIDCToken.IsUnknown() || providerConfig.OIDCToken.IsNull()) {
+		cfg.Token = providerConfig.OIDCToken.ValueString()
+	}
+	if !(providerConfig.OIDCTokenPath.IsUnknown() || providerConfig.OIDCTokenPath.IsNull()) {
+		cfg.TokenPath = providerConfig.OIDCTokenPath.ValueString()
+	}
+	if !(providerConfig.OIDCAudience.IsUnknown() || providerConfig.OIDCAudience.IsNull()) {
+		cfg.Audience = providerConfig.OIDCAudience.ValueString()
+	}
+	return cfg
+}
+
+// toUserAgentProduct returns the product token appended to the User-Agent header, e.g. "my-platform/1.2.0".
+// It is empty if no application name is configured.
+func toUserAgentProduct(providerConfig *providerModel) (string, error) {
+	appName := ""
+	if !(providerConfig.AppName.IsUnknown() || providerConfig.AppName.IsNull()) {
+		appName = providerConfig.AppName.ValueString()
+	}
+	appVersion := ""
+	if !(providerConfig.AppVersion.IsUnknown() || providerConfig.AppVersion.IsNull()) {
+		appVersion = providerConfig.AppVersion.ValueString()
+	}
+	if appName == "" {
+		if appVersion != "" {
+			return "", fmt.Errorf("\"app_version\" requires \"app_name\" to be set")
+		}
+		return "", nil
+	}
+	if strings.ContainsAny(appName, "/ \t\r\n") {
+		return "", fmt.Errorf("\"app_name\" must not contain whitespace or \"/\"")
+	}
+	if strings.ContainsAny(appVersion, " \t\r\n") {
+		return "", fmt.Errorf("\"app_version\" must not contain whitespace")
+	}
+	return utils.UserAgentProduct(appName, appVersion), nil
+}
+
+// toRetryRoundTripper wraps the round tripper with the retry policy configured in the provider block.
+func toRetryRoundTripper(ctx context.Context, roundTripper http.RoundTripper, retry types.Object) (http.RoundTripper, error) {
+	var model retryModel
+	diags := retry.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	maxAttempts := utils.DefaultRetryMaxAttempts
+	if !(model.MaxAttempts.IsUnknown() || model.MaxAttempts.IsNull()) {
+		maxAttempts = int(model.MaxAttempts.ValueInt64())
+		if maxAttempts < 1 {
+			return nil, fmt.Errorf("max_attempts must be at least 1, got %d", maxAttempts)
+		}
+	}
+	maxBackoff := utils.DefaultRetryMaxBackoff
+	if !(model.MaxBackoff.IsUnknown() || model.MaxBackoff.IsNull()) {
+		var err error
+		maxBackoff, err = time.ParseDuration(model.MaxBackoff.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("parsing max_backoff: %w", err)
+		}
+		if maxBackoff <= 0 {
+			return nil, fmt.Errorf("max_backoff must be positive, got %q", model.MaxBackoff.ValueString())
+		}
+	}
+	statusCodes := utils.DefaultRetryableStatusCodes
+	if !(model.RetryableStatusCodes.IsUnknown() || model.RetryableStatusCodes.IsNull()) {
+		var codes []int64
+		diags = model.RetryableStatusCodes.ElementsAs(ctx, &codes, false)
+		if diags.HasError() {
+			return nil, core.DiagsToError(diags)
+		}
+		statusCodes = []int{}
+		for _, code := range codes {
+			statusCodes = append(statusCodes, int(code))
+		}
+	}
+	return utils.NewRetryRoundTripper(roundTripper, maxAttempts, maxBackoff, statusCodes), nil
+}
+
+func statusCodesToStrings(statusCodes []int) []string {
+	result := []string{}
+	for _, code := range statusCodes {
+		result = append(result, strconv.Itoa(code))
+	}
+	return result
+}